@@ -0,0 +1,52 @@
+package slinq
+
+import "testing"
+
+// TestAllocationBudget verifies the allocation budgets that are documented in the README.
+func TestAllocationBudget(t *testing.T) {
+	slice := Range(0, 1000)
+	dict := ToMap(slice, func(i int) int { return i }, func(i int) int { return i })
+	double := func(i int) int { return i * 2 }
+	isEven := func(i int) bool { return i%2 == 0 }
+	add := func(a, b int) int { return a + b }
+	pair := func(k, v int) int { return k + v }
+	buffer := make([]int, 0, 2000)
+	// scratch is refilled before every in-place run, so that there is something to filter.
+	scratch := make([]int, len(slice))
+
+	tests := []struct {
+		name   string
+		budget float64
+		run    func()
+	}{
+		{name: "Append", budget: 1, run: func() { Append(slice, 1) }},
+		{name: "Chunk", budget: 2, run: func() { Chunk(slice, 7) }},
+		{name: "Concat", budget: 1, run: func() { Concat(slice, slice, slice) }},
+		{name: "Flatten", budget: 1, run: func() { Flatten([][]int{slice, slice}) }},
+		{name: "Interleave", budget: 1, run: func() { Interleave(slice, slice) }},
+		{name: "Prepend", budget: 1, run: func() { Prepend(slice, 1) }},
+		{name: "Range", budget: 1, run: func() { Range(0, 1000) }},
+		{name: "Repeat", budget: 1, run: func() { Repeat(1, 1000) }},
+		{name: "Reverse", budget: 1, run: func() { Reverse(slice) }},
+		{name: "Select", budget: 1, run: func() { Select(slice, double) }},
+		{name: "ToSlice", budget: 1, run: func() { ToSlice(dict, pair) }},
+		{name: "Zip", budget: 1, run: func() { Zip(slice, slice, add) }},
+		{name: "RepeatInto", budget: 0, run: func() { RepeatInto(buffer, 1, 1000) }},
+		{name: "ReverseInto", budget: 0, run: func() { ReverseInto(buffer, slice) }},
+		{name: "SelectInto", budget: 0, run: func() { SelectInto(buffer, slice, double) }},
+		{name: "ToSliceInto", budget: 0, run: func() { ToSliceInto(buffer, dict, pair) }},
+		{name: "WhereInto", budget: 0, run: func() { WhereInto(buffer, slice, isEven) }},
+		{name: "ZipInto", budget: 0, run: func() { ZipInto(buffer, slice, slice, add) }},
+		{name: "FilterInPlace", budget: 0, run: func() { copy(scratch, slice); FilterInPlace(scratch, isEven) }},
+		{name: "RemoveWhere", budget: 0, run: func() { copy(scratch, slice); RemoveWhere(scratch, isEven) }},
+		{name: "ReverseInPlace", budget: 0, run: func() { ReverseInPlace(slice) }},
+		{name: "MapInPlace", budget: 0, run: func() { MapInPlace(buffer[:10], double) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testing.AllocsPerRun(10, tt.run); got > tt.budget {
+				t.Errorf("%s allocated %v times, budget is %v", tt.name, got, tt.budget)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package slinq
+
+import "testing"
+
+// The benchmarks compare the operators with the equivalent hand-written loops, run them with -benchmem to see the allocations.
+
+var (
+	benchmarkSlice = Range(0, 100_000)
+	benchmarkSink  []int
+)
+
+func BenchmarkSelect(b *testing.B) {
+	double := func(i int) int { return i * 2 }
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = Select(benchmarkSlice, double)
+	}
+}
+
+func BenchmarkSelectLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		result := make([]int, len(benchmarkSlice))
+		for j, v := range benchmarkSlice {
+			result[j] = v * 2
+		}
+		benchmarkSink = result
+	}
+}
+
+func BenchmarkSelectInto(b *testing.B) {
+	double := func(i int) int { return i * 2 }
+	buffer := make([]int, 0, len(benchmarkSlice))
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = SelectInto(buffer[:0], benchmarkSlice, double)
+	}
+}
+
+func BenchmarkWhere(b *testing.B) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = Where(benchmarkSlice, isEven)
+	}
+}
+
+func BenchmarkWhereLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		var result []int
+		for _, v := range benchmarkSlice {
+			if v%2 == 0 {
+				result = append(result, v)
+			}
+		}
+		benchmarkSink = result
+	}
+}
+
+func BenchmarkWhereInto(b *testing.B) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	buffer := make([]int, 0, len(benchmarkSlice))
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = WhereInto(buffer[:0], benchmarkSlice, isEven)
+	}
+}
+
+func BenchmarkFilterInPlace(b *testing.B) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	buffer := make([]int, len(benchmarkSlice))
+	for i := 0; i < b.N; i++ {
+		copy(buffer, benchmarkSlice)
+		benchmarkSink = FilterInPlace(buffer, isEven)
+	}
+}
+
+func BenchmarkReverse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = Reverse(benchmarkSlice)
+	}
+}
+
+func BenchmarkReverseLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		result := make([]int, len(benchmarkSlice))
+		for j, v := range benchmarkSlice {
+			result[len(result)-1-j] = v
+		}
+		benchmarkSink = result
+	}
+}
+
+func BenchmarkRepeat(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = Repeat(7, len(benchmarkSlice))
+	}
+}
+
+func BenchmarkRepeatLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		result := make([]int, len(benchmarkSlice))
+		for j := range result {
+			result[j] = 7
+		}
+		benchmarkSink = result
+	}
+}
+
+func BenchmarkZip(b *testing.B) {
+	add := func(a, b int) int { return a + b }
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = Zip(benchmarkSlice, benchmarkSlice, add)
+	}
+}
+
+func BenchmarkZipLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		result := make([]int, len(benchmarkSlice))
+		for j := range result {
+			result[j] = benchmarkSlice[j] + benchmarkSlice[j]
+		}
+		benchmarkSink = result
+	}
+}
+
+func BenchmarkToSlice(b *testing.B) {
+	dict := ToMap(benchmarkSlice, func(i int) int { return i }, func(i int) int { return i })
+	pair := func(k, v int) int { return k + v }
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkSink = ToSlice(dict, pair)
+	}
+}
+
+func BenchmarkToSliceLoop(b *testing.B) {
+	dict := ToMap(benchmarkSlice, func(i int) int { return i }, func(i int) int { return i })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := make([]int, 0, len(dict))
+		for k, v := range dict {
+			result = append(result, k+v)
+		}
+		benchmarkSink = result
+	}
+}
@@ -0,0 +1,86 @@
+package slinq
+
+// FromChan returns a sequence that yields the values received from the provided channel until it is closed.
+// Iterating the sequence consumes the channel, so a second iteration continues where the first one stopped.
+// An iteration that stops early stops receiving, the sender is responsible for not blocking forever, e.g. by using a done channel.
+func FromChan[T any](ch <-chan T) Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ToChan iterates the provided sequence in a new goroutine and sends its values to the returned channel, which has the provided buffer size.
+// The channel is closed when the sequence is exhausted or the provided done channel is closed, e.g. ctx.Done(), after which the goroutine exits.
+// A consumer that stops receiving early must close done, otherwise the goroutine is blocked forever.
+func ToChan[T any](done <-chan struct{}, seq Seq[T], buffer int) <-chan T {
+	out := make(chan T, buffer)
+	go func() {
+		defer close(out)
+		seq(func(v T) bool {
+			select {
+			case out <- v:
+				return true
+			case <-done:
+				return false
+			}
+		})
+	}()
+	return out
+}
+
+// WhereChan returns a channel of the values received from the provided channel that satisfy the provided condition.
+// The returned channel is closed when the input channel is closed or the provided done channel is closed, after which the stage's goroutine exits.
+func WhereChan[T any](done <-chan struct{}, in <-chan T, condition func(T) bool) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				if !condition(v) {
+					continue
+				}
+				select {
+				case out <- v:
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// SelectChan returns a channel of the values received from the provided channel that have been modified by the provided selector.
+// The returned channel is closed when the input channel is closed or the provided done channel is closed, after which the stage's goroutine exits.
+func SelectChan[TSource any, TResult any](done <-chan struct{}, in <-chan TSource, selector func(TSource) TResult) <-chan TResult {
+	out := make(chan TResult)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case out <- selector(v):
+				case <-done:
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return out
+}
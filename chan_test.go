@@ -0,0 +1,92 @@
+package slinq
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// checkGoroutines fails the test if the number of goroutines doesn't return to the provided number.
+func checkGoroutines(t *testing.T, want int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > want {
+		if time.Now().After(deadline) {
+			t.Fatalf("NumGoroutine() = %v, want %v", runtime.NumGoroutine(), want)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 5)
+	for i := 1; i <= 5; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	if got, want := Collect(TakeSeq(FromChan(ch), 2)), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FromChan() = %v, want %v", got, want)
+	}
+	if got, want := Collect(FromChan(ch)), []int{3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FromChan() = %v, want %v", got, want)
+	}
+}
+
+func TestToChan(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	done := make(chan struct{})
+	defer close(done)
+
+	ch := ToChan(done, FromSlice([]int{1, 2, 3}), 1)
+	if got, want := Collect(FromChan(ch)), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToChan() = %v, want %v", got, want)
+	}
+	checkGoroutines(t, goroutines)
+}
+
+func TestToChanStopsEarly(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	done := make(chan struct{})
+
+	ch := ToChan(done, Iterate(0, func(v int) int { return v + 1 }), 0)
+	if got, want := Collect(TakeSeq(FromChan(ch), 3)), []int{0, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToChan() = %v, want %v", got, want)
+	}
+	close(done)
+	checkGoroutines(t, goroutines)
+}
+
+func TestChanStages(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	done := make(chan struct{})
+	defer close(done)
+
+	source := ToChan(done, FromSlice(Range(1, 6)), 0)
+	evens := WhereChan(done, source, func(v int) bool { return v%2 == 0 })
+	squares := SelectChan(done, evens, func(v int) int { return v * v })
+
+	if got, want := Collect(FromChan(squares)), []int{4, 16, 36}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectChan(WhereChan()) = %v, want %v", got, want)
+	}
+	checkGoroutines(t, goroutines)
+}
+
+func TestChanStagesStopEarly(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	done := make(chan struct{})
+
+	source := ToChan(done, Iterate(1, func(v int) int { return v + 1 }), 4)
+	odds := WhereChan(done, source, func(v int) bool { return v%2 == 1 })
+	strings := SelectChan(done, odds, func(v int) string { return string(rune('a' + v)) })
+
+	if got, want := <-strings, "b"; got != want {
+		t.Errorf("SelectChan() = %v, want %v", got, want)
+	}
+	close(done)
+	checkGoroutines(t, goroutines)
+	if v, ok := <-strings; ok {
+		t.Errorf("SelectChan() = %v after done was closed, want closed channel", v)
+	}
+}
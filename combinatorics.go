@@ -0,0 +1,162 @@
+package slinq
+
+// CartesianProduct returns a sequence that applies the provided selector to every pair of elements of the provided slices.
+// The pairs are produced in lexicographic index order, the index into the second slice varies fastest.
+func CartesianProduct[T1 any, T2 any, TResult any](first []T1, second []T2, selector func(T1, T2) TResult) Seq[TResult] {
+	return func(yield func(TResult) bool) {
+		for _, a := range first {
+			for _, b := range second {
+				if !yield(selector(a, b)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CartesianProductN returns a sequence of all the tuples that contain one element of each of the provided slices.
+// The tuples are produced in lexicographic index order, the index into the last slice varies fastest.
+// Without slices the sequence contains a single empty tuple, like Permutations and Combinations with k == 0.
+// Every yielded tuple is a newly allocated slice.
+func CartesianProductN[T any](slices ...[]T) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, s := range slices {
+			if len(s) == 0 {
+				return
+			}
+		}
+
+		indices := make([]int, len(slices))
+		for {
+			tuple := make([]T, len(slices))
+			for i, index := range indices {
+				tuple[i] = slices[i][index]
+			}
+			if !yield(tuple) {
+				return
+			}
+
+			i := len(indices) - 1
+			for ; i >= 0; i-- {
+				indices[i]++
+				if indices[i] < len(slices[i]) {
+					break
+				}
+				indices[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Permutations returns a sequence of all the ordered arrangements of size k of the elements of the provided slice.
+// The arrangements are produced in lexicographic index order. The sequence is empty when k is negative or greater than the length of the slice.
+// Every yielded arrangement is a newly allocated slice.
+func Permutations[T any](slice []T, k int) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(slice) {
+			return
+		}
+		used := make([]bool, len(slice))
+		indices := make([]int, 0, k)
+
+		var permute func() bool
+		permute = func() bool {
+			if len(indices) == k {
+				return yield(pick(slice, indices))
+			}
+			for i := range slice {
+				if used[i] {
+					continue
+				}
+				used[i] = true
+				indices = append(indices, i)
+				if !permute() {
+					return false
+				}
+				indices = indices[:len(indices)-1]
+				used[i] = false
+			}
+			return true
+		}
+		permute()
+	}
+}
+
+// Combinations returns a sequence of all the selections of k distinct elements of the provided slice, disregarding order.
+// The selections are produced in lexicographic index order. The sequence is empty when k is negative or greater than the length of the slice.
+// Every yielded selection is a newly allocated slice.
+func Combinations[T any](slice []T, k int) Seq[[]T] {
+	return combinations(slice, k, false)
+}
+
+// CombinationsWithReplacement returns a sequence of all the selections of k elements of the provided slice, disregarding order and allowing elements to be selected more than once.
+// The selections are produced in lexicographic index order. The sequence is empty when k is negative or when the slice is empty and k is not zero.
+// Every yielded selection is a newly allocated slice.
+func CombinationsWithReplacement[T any](slice []T, k int) Seq[[]T] {
+	return combinations(slice, k, true)
+}
+
+// PowerSet returns a sequence of all the subsets of the elements of the provided slice, including the empty set.
+// The subsets are produced in lexicographic index order, so every subset is directly followed by its extensions.
+// Every yielded subset is a newly allocated slice.
+func PowerSet[T any](slice []T) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		indices := make([]int, 0, len(slice))
+
+		var subsets func(start int) bool
+		subsets = func(start int) bool {
+			if !yield(pick(slice, indices)) {
+				return false
+			}
+			for i := start; i < len(slice); i++ {
+				indices = append(indices, i)
+				if !subsets(i + 1) {
+					return false
+				}
+				indices = indices[:len(indices)-1]
+			}
+			return true
+		}
+		subsets(0)
+	}
+}
+
+func combinations[T any](slice []T, k int, replacement bool) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || (!replacement && k > len(slice)) || (replacement && k > 0 && len(slice) == 0) {
+			return
+		}
+		indices := make([]int, 0, k)
+
+		var combine func(start int) bool
+		combine = func(start int) bool {
+			if len(indices) == k {
+				return yield(pick(slice, indices))
+			}
+			for i := start; i < len(slice); i++ {
+				indices = append(indices, i)
+				next := i + 1
+				if replacement {
+					next = i
+				}
+				if !combine(next) {
+					return false
+				}
+				indices = indices[:len(indices)-1]
+			}
+			return true
+		}
+		combine(0)
+	}
+}
+
+func pick[T any](slice []T, indices []int) []T {
+	result := make([]T, len(indices))
+	for i, index := range indices {
+		result[i] = slice[index]
+	}
+	return result
+}
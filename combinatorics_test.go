@@ -0,0 +1,133 @@
+package slinq
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCartesianProduct(t *testing.T) {
+	selector := func(i int, s string) string {
+		return fmt.Sprintf("%d%s", i, s)
+	}
+
+	want := []string{"1a", "1b", "2a", "2b"}
+	if got := Collect(CartesianProduct([]int{1, 2}, []string{"a", "b"}, selector)); !reflect.DeepEqual(got, want) {
+		t.Errorf("CartesianProduct() = %v, want %v", got, want)
+	}
+}
+
+func TestCartesianProductN(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices [][]int
+		want   [][]int
+	}{
+		{
+			name:   "Should return all tuples with the last index varying fastest",
+			slices: [][]int{{1, 2}, {3}, {4, 5}},
+			want:   [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}},
+		},
+		{
+			name:   "Should return empty sequence when one slice is empty",
+			slices: [][]int{{1, 2}, {}},
+			want:   nil,
+		},
+		{
+			name:   "Should return a single empty tuple for no slices",
+			slices: nil,
+			want:   [][]int{{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(CartesianProductN(tt.slices...)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CartesianProductN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	huge := CartesianProductN(Range(0, 1000), Range(0, 1000), Range(0, 1000))
+	if got := Collect(TakeSeq(huge, 2)); !reflect.DeepEqual(got, [][]int{{0, 0, 0}, {0, 0, 1}}) {
+		t.Errorf("CartesianProductN() = %v, want first two tuples", got)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	type args struct {
+		slice []string
+		k     int
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]string
+	}{
+		{
+			name: "Should return all arrangements of size 2",
+			args: args{slice: []string{"a", "b", "c"}, k: 2},
+			want: [][]string{{"a", "b"}, {"a", "c"}, {"b", "a"}, {"b", "c"}, {"c", "a"}, {"c", "b"}},
+		},
+		{
+			name: "Should return one empty arrangement",
+			args: args{slice: []string{"a", "b", "c"}, k: 0},
+			want: [][]string{{}},
+		},
+		{
+			name: "Should return empty sequence because k is too large",
+			args: args{slice: []string{"a", "b", "c"}, k: 4},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(Permutations(tt.args.slice, tt.args.k)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Permutations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	type args struct {
+		slice []int
+		k     int
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]int
+	}{
+		{
+			name: "Should return all selections of size 2",
+			args: args{slice: []int{1, 2, 3, 4}, k: 2},
+			want: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}},
+		},
+		{
+			name: "Should return empty sequence because k is negative",
+			args: args{slice: []int{1, 2, 3, 4}, k: -1},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(Combinations(tt.args.slice, tt.args.k)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Combinations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	want := [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}}
+	if got := Collect(CombinationsWithReplacement([]int{1, 2, 3}, 2)); !reflect.DeepEqual(got, want) {
+		t.Errorf("CombinationsWithReplacement() = %v, want %v", got, want)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	want := [][]int{{}, {1}, {1, 2}, {1, 2, 3}, {1, 3}, {2}, {2, 3}, {3}}
+	if got := Collect(PowerSet([]int{1, 2, 3})); !reflect.DeepEqual(got, want) {
+		t.Errorf("PowerSet() = %v, want %v", got, want)
+	}
+}
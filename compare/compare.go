@@ -0,0 +1,187 @@
+// Package compare contains composable comparers for the ...With operators of slinq, e.g. OrderByWith, MinByWith, TopKWith and SortedUnionWith.
+// Use Less to turn a comparer into the less function that MergeSorted and NewPriorityQueue expect.
+package compare
+
+import (
+	"math"
+	"unicode/utf8"
+
+	"slinq"
+	"slinq/internal/fold"
+)
+
+// Ordered orders values of an ordered type with the < operator.
+// NaN is neither less nor greater than any float, so use FloatTotalOrder for floats that can be NaN.
+func Ordered[T slinq.Ordered]() slinq.Comparer[T] {
+	return slinq.ComparerFunc[T](func(a, b T) int {
+		switch {
+		case a < b:
+			return -1
+		case b < a:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// By orders values by the keys the provided selector returns.
+func By[T any, TKey slinq.Ordered](keySelector func(T) TKey) slinq.Comparer[T] {
+	return ByWith(keySelector, Ordered[TKey]())
+}
+
+// ByWith orders values by the keys the provided selector returns, using the provided comparer for the keys.
+func ByWith[T any, TKey any](keySelector func(T) TKey, comparer slinq.Comparer[TKey]) slinq.Comparer[T] {
+	return slinq.ComparerFunc[T](func(a, b T) int {
+		return comparer.Compare(keySelector(a), keySelector(b))
+	})
+}
+
+// Reverse inverts the order of the provided comparer.
+func Reverse[T any](comparer slinq.Comparer[T]) slinq.Comparer[T] {
+	return slinq.ComparerFunc[T](func(a, b T) int {
+		return comparer.Compare(b, a)
+	})
+}
+
+// Then orders values by the first provided comparer and uses the following comparers, in order, for values the previous ones consider equal.
+func Then[T any](first slinq.Comparer[T], then ...slinq.Comparer[T]) slinq.Comparer[T] {
+	return slinq.ComparerFunc[T](func(a, b T) int {
+		if c := first.Compare(a, b); c != 0 {
+			return c
+		}
+		for _, comparer := range then {
+			if c := comparer.Compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+}
+
+// Less returns a function that reports whether a comes before b according to the provided comparer.
+func Less[T any](comparer slinq.Comparer[T]) func(a, b T) bool {
+	return func(a, b T) bool {
+		return comparer.Compare(a, b) < 0
+	}
+}
+
+// NilsFirst orders nil pointers before all other pointers and the values of the other pointers with the provided comparer.
+func NilsFirst[T any](comparer slinq.Comparer[T]) slinq.Comparer[*T] {
+	return nils(comparer, -1)
+}
+
+// NilsLast orders nil pointers after all other pointers and the values of the other pointers with the provided comparer.
+func NilsLast[T any](comparer slinq.Comparer[T]) slinq.Comparer[*T] {
+	return nils(comparer, 1)
+}
+
+func nils[T any](comparer slinq.Comparer[T], nilOrder int) slinq.Comparer[*T] {
+	return slinq.ComparerFunc[*T](func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilOrder
+		case b == nil:
+			return -nilOrder
+		default:
+			return comparer.Compare(*a, *b)
+		}
+	})
+}
+
+// FloatTotalOrder orders floats ascending and places NaN after positive infinity, all NaNs are equal.
+// Negative and positive zero are equal, like they are for the == operator.
+func FloatTotalOrder[T slinq.Float]() slinq.Comparer[T] {
+	return slinq.ComparerFunc[T](func(a, b T) int {
+		aNaN, bNaN := math.IsNaN(float64(a)), math.IsNaN(float64(b))
+		switch {
+		case aNaN && bNaN:
+			return 0
+		case aNaN:
+			return 1
+		case bNaN:
+			return -1
+		case a < b:
+			return -1
+		case b < a:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
+
+// CaseInsensitive orders strings rune by rune under simple Unicode case folding, so strings that strings.EqualFold considers equal are equal.
+func CaseInsensitive() slinq.Comparer[string] {
+	return slinq.ComparerFunc[string](func(a, b string) int {
+		for a != "" && b != "" {
+			ra, na := utf8.DecodeRuneInString(a)
+			rb, nb := utf8.DecodeRuneInString(b)
+			if fa, fb := fold.Rune(ra), fold.Rune(rb); fa != fb {
+				if fa < fb {
+					return -1
+				}
+				return 1
+			}
+			a, b = a[na:], b[nb:]
+		}
+		return len(a) - len(b)
+	})
+}
+
+// NaturalString orders strings so that runs of digits are compared by their numeric value, e.g. "file2" comes before "file10".
+// Other characters are compared bytewise. Numbers that only differ in leading zeros are ordered by the number of leading zeros.
+func NaturalString() slinq.Comparer[string] {
+	return slinq.ComparerFunc[string](func(a, b string) int {
+		zeros := 0
+		for a != "" && b != "" {
+			if isDigit(a[0]) && isDigit(b[0]) {
+				da, db := digitRun(a), digitRun(b)
+				na, nb := trimZeros(a[:da]), trimZeros(b[:db])
+				if len(na) != len(nb) {
+					return len(na) - len(nb)
+				}
+				if na != nb {
+					if na < nb {
+						return -1
+					}
+					return 1
+				}
+				if zeros == 0 {
+					zeros = da - db
+				}
+				a, b = a[da:], b[db:]
+				continue
+			}
+			if a[0] != b[0] {
+				return int(a[0]) - int(b[0])
+			}
+			a, b = a[1:], b[1:]
+		}
+		if len(a) != len(b) {
+			return len(a) - len(b)
+		}
+		return zeros
+	})
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func digitRun(s string) int {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+func trimZeros(digits string) string {
+	for len(digits) > 1 && digits[0] == '0' {
+		digits = digits[1:]
+	}
+	return digits
+}
@@ -0,0 +1,104 @@
+package compare
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"slinq"
+)
+
+func TestNaturalString(t *testing.T) {
+	slice := []string{"file10", "file2", "file1", "file02", "File3", "file", "file2a", "a100b2", "a100b10"}
+
+	want := []string{"File3", "a100b2", "a100b10", "file", "file1", "file2", "file02", "file2a", "file10"}
+	if got := slinq.OrderByWith(slice, NaturalString()); !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderByWith(NaturalString()) = %v, want %v", got, want)
+	}
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	slice := []string{"banana", "Apple", "cherry", "apple", "BANANA"}
+
+	want := []string{"Apple", "apple", "banana", "BANANA", "cherry"}
+	if got := slinq.OrderByWith(slice, CaseInsensitive()); !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderByWith(CaseInsensitive()) = %v, want %v", got, want)
+	}
+}
+
+func TestReverseThenBy(t *testing.T) {
+	type employee struct {
+		name string
+		age  int
+	}
+	employees := []employee{{"bob", 30}, {"alice", 25}, {"carol", 30}, {"dave", 25}}
+	age := func(e employee) int { return e.age }
+	name := func(e employee) string { return e.name }
+
+	want := []employee{{"bob", 30}, {"carol", 30}, {"alice", 25}, {"dave", 25}}
+	if got := slinq.OrderByWith(employees, Then(Reverse(By(age)), By(name))); !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderByWith(Then(Reverse(By(age)), By(name))) = %v, want %v", got, want)
+	}
+
+	if got, _ := slinq.MaxByWith(employees, Then(By(age), Reverse(By(name)))); got != (employee{"bob", 30}) {
+		t.Errorf("MaxByWith() = %v, want %v", got, employee{"bob", 30})
+	}
+}
+
+func TestNils(t *testing.T) {
+	one, two := 1, 2
+	slice := []*int{&two, nil, &one}
+
+	first := slinq.OrderByWith(slice, NilsFirst(Ordered[int]()))
+	if first[0] != nil || *first[1] != 1 || *first[2] != 2 {
+		t.Errorf("OrderByWith(NilsFirst()) = %v, want [nil 1 2]", first)
+	}
+	last := slinq.OrderByWith(slice, NilsLast(Ordered[int]()))
+	if *last[0] != 1 || *last[1] != 2 || last[2] != nil {
+		t.Errorf("OrderByWith(NilsLast()) = %v, want [1 2 nil]", last)
+	}
+}
+
+func TestFloatTotalOrder(t *testing.T) {
+	nan := math.NaN()
+	slice := []float64{3, nan, math.Inf(1), -1, nan, math.Inf(-1)}
+
+	got := slinq.OrderByWith(slice, FloatTotalOrder[float64]())
+	want := []float64{math.Inf(-1), -1, 3, math.Inf(1)}
+	if !reflect.DeepEqual(got[:4], want) || !math.IsNaN(got[4]) || !math.IsNaN(got[5]) {
+		t.Errorf("OrderByWith(FloatTotalOrder()) = %v, want %v followed by NaNs", got, want)
+	}
+	if FloatTotalOrder[float64]().Compare(math.Copysign(0, -1), 0) != 0 {
+		t.Errorf("FloatTotalOrder() does not consider -0 and +0 equal")
+	}
+}
+
+func TestPluggability(t *testing.T) {
+	byLength := By(func(s string) int { return len(s) })
+	slice := []string{"ccc", "a", "bb", "dddd", "e"}
+
+	if got, want := slinq.TopKWith(slice, 2, byLength), []string{"dddd", "ccc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TopKWith() = %v, want %v", got, want)
+	}
+	if got, want := slinq.BottomKWith(slice, 2, byLength), []string{"a", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("BottomKWith() = %v, want %v", got, want)
+	}
+	if got, _ := slinq.MinByWith(slice, byLength); got != "a" {
+		t.Errorf("MinByWith() = %v, want a", got)
+	}
+
+	descending := Reverse(Ordered[int]())
+	first, second := []int{9, 7, 5, 3}, []int{8, 7, 3, 1}
+	if got, want := slinq.SortedIntersectWith(first, second, descending), []int{7, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedIntersectWith() = %v, want %v", got, want)
+	}
+	if got, want := slinq.SortedUnionWith(first, second, descending), []int{9, 8, 7, 5, 3, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedUnionWith() = %v, want %v", got, want)
+	}
+	if got, want := slinq.SortedExceptWith(first, second, descending), []int{9, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedExceptWith() = %v, want %v", got, want)
+	}
+	if got, want := slinq.MergeSorted(Less(descending), first, second), []int{9, 8, 7, 7, 5, 3, 3, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSorted(Less()) = %v, want %v", got, want)
+	}
+}
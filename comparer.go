@@ -0,0 +1,175 @@
+package slinq
+
+import (
+	"bytes"
+	"errors"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"slinq/internal/fold"
+)
+
+// EqualityComparer decides whether two values are equal, for element types that are not comparable or need a custom notion of equality.
+// Values that are equal must have the same hash.
+type EqualityComparer[T any] interface {
+	Equals(a, b T) bool
+	Hash(value T) uint64
+}
+
+// Comparer orders two values. Compare returns a negative number when a comes before b, a positive number when a comes after b and zero otherwise.
+type Comparer[T any] interface {
+	Compare(a, b T) int
+}
+
+// ComparerFunc adapts an ordinary function to the Comparer interface.
+type ComparerFunc[T any] func(a, b T) int
+
+// Compare returns f(a, b).
+func (f ComparerFunc[T]) Compare(a, b T) int {
+	return f(a, b)
+}
+
+type equalityComparer[T any] struct {
+	equals func(T, T) bool
+	hash   func(T) uint64
+}
+
+func (c equalityComparer[T]) Equals(a, b T) bool  { return c.equals(a, b) }
+func (c equalityComparer[T]) Hash(value T) uint64 { return c.hash(value) }
+
+// NewEqualityComparer returns an EqualityComparer that uses the provided functions.
+func NewEqualityComparer[T any](equals func(T, T) bool, hash func(T) uint64) EqualityComparer[T] {
+	return equalityComparer[T]{equals, hash}
+}
+
+// BytesComparer compares byte slices by their content, nil and empty slices are equal.
+func BytesComparer() EqualityComparer[[]byte] {
+	return NewEqualityComparer(bytes.Equal, func(value []byte) uint64 {
+		h := fnv.New64a()
+		h.Write(value)
+		return h.Sum64()
+	})
+}
+
+// CaseInsensitiveComparer compares strings like strings.EqualFold, i.e. under simple Unicode case folding.
+func CaseInsensitiveComparer() EqualityComparer[string] {
+	return NewEqualityComparer(strings.EqualFold, func(value string) uint64 {
+		h := fnv.New64a()
+		var buf [utf8.UTFMax]byte
+		for _, r := range value {
+			n := utf8.EncodeRune(buf[:], fold.Rune(r))
+			h.Write(buf[:n])
+		}
+		return h.Sum64()
+	})
+}
+
+// FloatToleranceComparer considers two floats equal when they differ by at most the provided epsilon, NaN is equal to NaN.
+// Equality within a tolerance is not transitive, so all values have the same hash and the ...With operators take quadratic time with it.
+// Returns an error if epsilon is negative, NaN or infinite, because a value would then not be equal to itself.
+func FloatToleranceComparer(epsilon float64) (EqualityComparer[float64], error) {
+	if !(epsilon >= 0) || math.IsInf(epsilon, 0) {
+		return nil, errors.New("epsilon must be a non-negative finite number")
+	}
+	return NewEqualityComparer(func(a, b float64) bool {
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return math.IsNaN(a) && math.IsNaN(b)
+		}
+		if math.IsInf(a, 0) || math.IsInf(b, 0) {
+			return a == b
+		}
+		return math.Abs(a-b) <= epsilon
+	}, func(float64) uint64 {
+		return 0
+	}), nil
+}
+
+// DeepEqualComparer compares values with reflect.DeepEqual, e.g. maps, slices and structs with slice fields.
+func DeepEqualComparer[T any]() EqualityComparer[T] {
+	return NewEqualityComparer(func(a, b T) bool {
+		return reflect.DeepEqual(a, b)
+	}, func(value T) uint64 {
+		h := fnv.New64a()
+		deepHash(h, reflect.ValueOf(&value).Elem(), 0)
+		return h.Sum64()
+	})
+}
+
+// deepHash writes a hash of the provided value that is equal for values that reflect.DeepEqual considers equal.
+// Pointers are followed up to a fixed depth to stay finite for cyclic values.
+func deepHash(h interface{ Write([]byte) (int, error) }, v reflect.Value, depth int) {
+	var buf [8]byte
+	writeUint := func(u uint64) {
+		for i := range buf {
+			buf[i] = byte(u >> (8 * i))
+		}
+		h.Write(buf[:])
+	}
+
+	if !v.IsValid() {
+		writeUint(0)
+		return
+	}
+	writeUint(uint64(v.Kind()))
+	if depth > 16 {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint(1)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f == 0 {
+			f = 0 // +0 and -0 are equal
+		}
+		writeUint(math.Float64bits(f))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		re, im := real(c), imag(c)
+		if re == 0 {
+			re = 0
+		}
+		if im == 0 {
+			im = 0
+		}
+		writeUint(math.Float64bits(re))
+		writeUint(math.Float64bits(im))
+	case reflect.String:
+		h.Write([]byte(v.String()))
+	case reflect.Array, reflect.Slice:
+		writeUint(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			deepHash(h, v.Index(i), depth+1)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			deepHash(h, v.Field(i), depth+1)
+		}
+	case reflect.Map:
+		// the entries are combined order-independently since map iteration order is random
+		writeUint(uint64(v.Len()))
+		var sum uint64
+		iter := v.MapRange()
+		for iter.Next() {
+			entry := fnv.New64a()
+			deepHash(entry, iter.Key(), depth+1)
+			deepHash(entry, iter.Value(), depth+1)
+			sum += entry.Sum64()
+		}
+		writeUint(sum)
+	case reflect.Pointer, reflect.Interface:
+		deepHash(h, v.Elem(), depth+1)
+	case reflect.Chan, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	}
+}
@@ -0,0 +1,108 @@
+package slinq
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBuiltinComparers(t *testing.T) {
+	type record struct {
+		tags  []string
+		attrs map[string]interface{}
+		ptr   *int
+	}
+	one, otherOne := 1, 1
+
+	bytesComparer := BytesComparer()
+	caseInsensitive := CaseInsensitiveComparer()
+	tolerance := mustComparer(FloatToleranceComparer(0.01))
+	deep := DeepEqualComparer[record]()
+
+	tests := []struct {
+		name      string
+		equal     bool
+		sameHash  bool
+		wantEqual bool
+	}{
+		{
+			name:      "Bytes with equal content",
+			equal:     bytesComparer.Equals([]byte("abc"), []byte("abc")),
+			sameHash:  bytesComparer.Hash([]byte("abc")) == bytesComparer.Hash([]byte("abc")),
+			wantEqual: true,
+		},
+		{
+			name:      "Bytes nil and empty",
+			equal:     bytesComparer.Equals(nil, []byte{}),
+			sameHash:  bytesComparer.Hash(nil) == bytesComparer.Hash([]byte{}),
+			wantEqual: true,
+		},
+		{
+			name:      "Bytes with different content",
+			equal:     bytesComparer.Equals([]byte("abc"), []byte("abd")),
+			sameHash:  true,
+			wantEqual: false,
+		},
+		{
+			name:      "Strings that differ in case",
+			equal:     caseInsensitive.Equals("Hello", "hELLO"),
+			sameHash:  caseInsensitive.Hash("Hello") == caseInsensitive.Hash("hELLO"),
+			wantEqual: true,
+		},
+		{
+			name:      "Strings with long s and Kelvin sign",
+			equal:     caseInsensitive.Equals("ſK", "sK"),
+			sameHash:  caseInsensitive.Hash("ſK") == caseInsensitive.Hash("sK"),
+			wantEqual: true,
+		},
+		{
+			name:      "Floats within tolerance",
+			equal:     tolerance.Equals(1.0, 1.005),
+			sameHash:  tolerance.Hash(1.0) == tolerance.Hash(1.005),
+			wantEqual: true,
+		},
+		{
+			name:      "Floats outside tolerance",
+			equal:     tolerance.Equals(1.0, 1.02),
+			sameHash:  true,
+			wantEqual: false,
+		},
+		{
+			name:      "NaN and NaN with tolerance",
+			equal:     tolerance.Equals(math.NaN(), math.NaN()),
+			sameHash:  true,
+			wantEqual: true,
+		},
+		{
+			name: "Deep equal structs with slices, maps and pointers",
+			equal: deep.Equals(
+				record{[]string{"a"}, map[string]interface{}{"x": 1, "y": []int{2}}, &one},
+				record{[]string{"a"}, map[string]interface{}{"y": []int{2}, "x": 1}, &otherOne}),
+			sameHash: deep.Hash(record{[]string{"a"}, map[string]interface{}{"x": 1, "y": []int{2}}, &one}) ==
+				deep.Hash(record{[]string{"a"}, map[string]interface{}{"y": []int{2}, "x": 1}, &otherOne}),
+			wantEqual: true,
+		},
+		{
+			name:      "Deep different structs",
+			equal:     deep.Equals(record{tags: []string{"a"}}, record{tags: []string{"b"}}),
+			sameHash:  true,
+			wantEqual: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.equal != tt.wantEqual {
+				t.Errorf("Equals() = %v, want %v", tt.equal, tt.wantEqual)
+			}
+			if tt.wantEqual && !tt.sameHash {
+				t.Errorf("Hash() differs for equal values")
+			}
+		})
+	}
+}
+
+func TestComparerFunc(t *testing.T) {
+	var c Comparer[int] = ComparerFunc[int](func(a, b int) int { return a - b })
+	if c.Compare(1, 2) >= 0 || c.Compare(2, 1) <= 0 || c.Compare(2, 2) != 0 {
+		t.Errorf("ComparerFunc.Compare() did not call the function")
+	}
+}
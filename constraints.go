@@ -0,0 +1,22 @@
+package slinq
+
+// Integer is a constraint that permits any signed or unsigned integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Ordered is a constraint that permits any type that supports the < operator.
+type Ordered interface {
+	Integer | Float | ~string
+}
@@ -0,0 +1,136 @@
+package slinq
+
+import "context"
+
+// DefaultContextCheckInterval is the number of elements the ...Ctx operators process between two checks of whether their context is done.
+// The ...CtxN variants take the interval as an argument instead: lower values react faster to cancellation, higher values cost less time per element.
+const DefaultContextCheckInterval = 1024
+
+// checkContext returns the error of the provided context if it is done and the element at the provided index is due for a check.
+// Intervals below 1 check every element.
+func checkContext(ctx context.Context, index int, interval int) error {
+	if interval > 1 && index%interval != 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return nil
+	}
+}
+
+// AggregateCtx is Aggregate that stops once the provided context is done.
+// It then returns the value that was accumulated so far together with the error of the context.
+func AggregateCtx[T any](ctx context.Context, slice []T, initial T, accumulator func(T, T) T) (T, error) {
+	return AggregateCtxN(ctx, slice, DefaultContextCheckInterval, initial, accumulator)
+}
+
+// AggregateCtxN is AggregateCtx that checks the context every interval elements.
+func AggregateCtxN[T any](ctx context.Context, slice []T, interval int, initial T, accumulator func(T, T) T) (T, error) {
+	result := initial
+	for i, v := range slice {
+		if err := checkContext(ctx, i, interval); err != nil {
+			return result, err
+		}
+		result = accumulator(result, v)
+	}
+	return result, nil
+}
+
+// SelectCtx is Select that stops once the provided context is done.
+// It then returns the elements that were selected so far together with the error of the context.
+func SelectCtx[TSource any, TResult any](ctx context.Context, slice []TSource, selector func(TSource) TResult) ([]TResult, error) {
+	return SelectCtxN(ctx, slice, DefaultContextCheckInterval, selector)
+}
+
+// SelectCtxN is SelectCtx that checks the context every interval elements.
+func SelectCtxN[TSource any, TResult any](ctx context.Context, slice []TSource, interval int, selector func(TSource) TResult) ([]TResult, error) {
+	result := make([]TResult, 0, len(slice))
+	for i, v := range slice {
+		if err := checkContext(ctx, i, interval); err != nil {
+			return emptyResult(result), err
+		}
+		result = append(result, selector(v))
+	}
+	return emptyResult(result), nil
+}
+
+// SelectManyCtx is SelectMany that stops once the provided context is done.
+// It then returns the elements that were selected so far together with the error of the context.
+func SelectManyCtx[TSource any, TResult any](ctx context.Context, slice []TSource, selector func(TSource, int) []TResult) ([]TResult, error) {
+	return SelectManyCtxN(ctx, slice, DefaultContextCheckInterval, selector)
+}
+
+// SelectManyCtxN is SelectManyCtx that checks the context every interval elements of the provided slice.
+func SelectManyCtxN[TSource any, TResult any](ctx context.Context, slice []TSource, interval int, selector func(TSource, int) []TResult) ([]TResult, error) {
+	var result []TResult
+	for i, outer := range slice {
+		if err := checkContext(ctx, i, interval); err != nil {
+			return emptyResult(result), err
+		}
+		result = append(result, selector(outer, i)...)
+	}
+	return emptyResult(result), nil
+}
+
+// WhereCtx is Where that stops once the provided context is done.
+// It then returns the elements that satisfied the condition so far together with the error of the context.
+func WhereCtx[T any](ctx context.Context, slice []T, condition func(T) bool) ([]T, error) {
+	return WhereCtxN(ctx, slice, DefaultContextCheckInterval, condition)
+}
+
+// WhereCtxN is WhereCtx that checks the context every interval elements.
+func WhereCtxN[T any](ctx context.Context, slice []T, interval int, condition func(T) bool) ([]T, error) {
+	var result []T
+	for i, v := range slice {
+		if err := checkContext(ctx, i, interval); err != nil {
+			return emptyResult(result), err
+		}
+		if condition(v) {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result), nil
+}
+
+// CollectCtx is Collect that stops once the provided context is done.
+// It then returns the elements that were collected so far together with the error of the context.
+//
+// The context is checked before the first element is requested and then after collected elements, so it can only be checked when the sequence yields.
+// A pipeline that runs long without yielding, e.g. WhereSeq over an infinite sequence with a rare condition, has to stop itself:
+// put TakeUntilDoneSeq at its source, CollectCtx then reports the error of the context when the sequence ends early.
+func CollectCtx[T any](ctx context.Context, seq Seq[T]) ([]T, error) {
+	return CollectCtxN(ctx, seq, DefaultContextCheckInterval)
+}
+
+// CollectCtxN is CollectCtx that checks the context every interval collected elements.
+func CollectCtxN[T any](ctx context.Context, seq Seq[T], interval int) ([]T, error) {
+	if err := checkContext(ctx, 0, 1); err != nil {
+		return emptyResult[T](nil), err
+	}
+	var result []T
+	var err error
+	seq(func(v T) bool {
+		result = append(result, v)
+		err = checkContext(ctx, len(result), interval)
+		return err == nil
+	})
+	if err == nil {
+		err = checkContext(ctx, 0, 1)
+	}
+	return emptyResult(result), err
+}
+
+// TakeUntilDoneSeq returns a sequence of the elements of the provided sequence that ends once the provided context is done.
+// The context is checked before every element, so it bounds infinite sequences and the pipelines that are built on them even when they rarely yield.
+func TakeUntilDoneSeq[T any](ctx context.Context, seq Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		if checkContext(ctx, 0, 1) != nil {
+			return
+		}
+		seq(func(v T) bool {
+			return checkContext(ctx, 0, 1) == nil && yield(v)
+		})
+	}
+}
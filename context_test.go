@@ -0,0 +1,134 @@
+package slinq
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// cancelAfter returns a context that the returned function cancels when it is called for the provided number of times.
+func cancelAfter(count int) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	return ctx, func() {
+		if calls++; calls == count {
+			cancel()
+		}
+	}
+}
+
+func TestCtxOperators(t *testing.T) {
+	slice := Range(1, 10)
+
+	tests := []struct {
+		name    string
+		run     func(context.Context, func()) (any, error)
+		want    any
+		wantErr error
+	}{
+		{
+			name: "SelectCtx",
+			run: func(ctx context.Context, tick func()) (any, error) {
+				return SelectCtxN(ctx, slice, 2, func(v int) int { tick(); return v * 10 })
+			},
+			want:    []int{10, 20, 30, 40},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "WhereCtx",
+			run: func(ctx context.Context, tick func()) (any, error) {
+				return WhereCtxN(ctx, slice, 2, func(v int) bool { tick(); return v%2 == 1 })
+			},
+			want:    []int{1, 3},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "AggregateCtx",
+			run: func(ctx context.Context, tick func()) (any, error) {
+				return AggregateCtxN(ctx, slice, 2, 0, func(a, v int) int { tick(); return a + v })
+			},
+			want:    10,
+			wantErr: context.Canceled,
+		},
+		{
+			name: "SelectManyCtx",
+			run: func(ctx context.Context, tick func()) (any, error) {
+				return SelectManyCtxN(ctx, slice, 2, func(v, _ int) []int { tick(); return []int{v, -v} })
+			},
+			want:    []int{1, -1, 2, -2, 3, -3, 4, -4},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "CollectCtx",
+			run: func(ctx context.Context, tick func()) (any, error) {
+				return CollectCtxN(ctx, SelectSeq(Iterate(1, func(v int) int { return v + 1 }), func(v int) int { tick(); return v }), 2)
+			},
+			want:    []int{1, 2, 3, 4},
+			wantErr: context.Canceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.run(cancelAfter(3))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s() error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCtxOperatorsComplete(t *testing.T) {
+	ctx := context.Background()
+
+	if got, err := SelectCtx(ctx, []int{1, 2}, func(v int) int { return v + 1 }); err != nil || !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("SelectCtx() = %v, %v, want [2 3], nil", got, err)
+	}
+	if got, err := WhereCtx(ctx, []int{1, 2}, func(v int) bool { return v > 5 }); err != nil || got != nil {
+		t.Errorf("WhereCtx() = %#v, %v, want nil, nil", got, err)
+	}
+	if got, err := AggregateCtx(ctx, []int{1, 2, 3}, 0, func(a, v int) int { return a + v }); err != nil || got != 6 {
+		t.Errorf("AggregateCtx() = %v, %v, want 6, nil", got, err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if got, err := SelectCtx(cancelled, []int{1, 2}, func(v int) int { return v }); !errors.Is(err, context.Canceled) || got != nil {
+		t.Errorf("SelectCtx() = %#v, %v, want nil, context.Canceled", got, err)
+	}
+}
+
+func TestCollectCtx(t *testing.T) {
+	naturals := Iterate(0, func(v int) int { return v + 1 })
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got, err := CollectCtx(cancelled, naturals); !errors.Is(err, context.Canceled) || got != nil {
+		t.Errorf("CollectCtx() = %v elements, %v, want 0, context.Canceled", len(got), err)
+	}
+
+	ctx, tick := cancelAfter(3)
+	rare := WhereSeq(TakeUntilDoneSeq(ctx, SelectSeq(naturals, func(v int) int { tick(); return v })), func(v int) bool { return v > 1000 })
+	if got, err := CollectCtx(ctx, rare); !errors.Is(err, context.Canceled) || got != nil {
+		t.Errorf("CollectCtx(TakeUntilDoneSeq()) = %v, %v, want nil, context.Canceled", got, err)
+	}
+
+	if got, err := CollectCtx(context.Background(), TakeSeq(naturals, 3)); err != nil || !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("CollectCtx() = %v, %v, want [0 1 2], nil", got, err)
+	}
+}
+
+func TestTakeUntilDoneSeq(t *testing.T) {
+	ctx, tick := cancelAfter(2)
+	seq := TakeUntilDoneSeq(ctx, SelectSeq(Iterate(1, func(v int) int { return v + 1 }), func(v int) int { tick(); return v }))
+
+	if got, want := Collect(seq), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeUntilDoneSeq() = %v, want %v", got, want)
+	}
+	if got := Collect(seq); got != nil {
+		t.Errorf("TakeUntilDoneSeq() = %v after cancellation, want nil", got)
+	}
+}
@@ -0,0 +1,16 @@
+package slinq
+
+// Debug enables additional precondition checks, e.g. whether the input of the Sorted... functions is actually sorted.
+// A violated precondition causes a panic. The checks cost additional time, so Debug should not be enabled in production.
+var Debug = false
+
+func checkSorted[T any](function string, compare func(T, T) int, slices ...[]T) {
+	if !Debug {
+		return
+	}
+	for _, slice := range slices {
+		if !isSorted(slice, compare) {
+			panic("slinq: " + function + " requires sorted input")
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Package dict contains LINQ-like operators for maps.
+// Like the slinq operators, they never modify the provided maps and always return a non-nil map, also together with an error.
+package dict
+
+import "slinq"
+
+// WhereMap returns a map that contains the entries of the provided map that satisfy the provided condition.
+func WhereMap[TKey comparable, TValue any](dict map[TKey]TValue, condition func(TKey, TValue) bool) map[TKey]TValue {
+	result := make(map[TKey]TValue)
+	for key, value := range dict {
+		if condition(key, value) {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// SelectValues returns a map with the keys of the provided map and the values the provided selector returns for its entries.
+func SelectValues[TKey comparable, TValue any, TResult any](dict map[TKey]TValue, selector func(TKey, TValue) TResult) map[TKey]TResult {
+	result := make(map[TKey]TResult, len(dict))
+	for key, value := range dict {
+		result[key] = selector(key, value)
+	}
+	return result
+}
+
+// SelectKeys returns a map with the keys the provided selector returns for the entries of the provided map and their values.
+// Returns a *slinq.DuplicateKeyError if the selector returns the same key for more than one entry.
+func SelectKeys[TKey comparable, TValue any, TResult comparable](dict map[TKey]TValue, selector func(TKey, TValue) TResult) (map[TResult]TValue, error) {
+	result := make(map[TResult]TValue, len(dict))
+	for key, value := range dict {
+		newKey := selector(key, value)
+		if _, exists := result[newKey]; exists {
+			return result, &slinq.DuplicateKeyError[TResult]{Key: newKey}
+		}
+		result[newKey] = value
+	}
+	return result, nil
+}
+
+// MergeMaps returns a map that contains the entries of all the provided maps.
+// The provided resolver decides the value of keys that occur in more than one map, it receives the values in the order of the maps.
+// If the resolver returns an error, MergeMaps stops and returns the error.
+func MergeMaps[TKey comparable, TValue any](resolver slinq.DuplicateKeyResolver[TKey, TValue], dicts ...map[TKey]TValue) (map[TKey]TValue, error) {
+	length := 0
+	for _, dict := range dicts {
+		length += len(dict)
+	}
+	result := make(map[TKey]TValue, length)
+	for _, dict := range dicts {
+		for key, value := range dict {
+			if existing, exists := result[key]; exists {
+				resolved, err := resolver(key, existing, value)
+				if err != nil {
+					return result, err
+				}
+				value = resolved
+			}
+			result[key] = value
+		}
+	}
+	return result, nil
+}
+
+// InvertMap returns a map from the values of the provided map to their keys.
+// Returns a *slinq.DuplicateKeyError with the value if more than one key has the same value, use GroupValues to keep all of them.
+func InvertMap[TKey comparable, TValue comparable](dict map[TKey]TValue) (map[TValue]TKey, error) {
+	result := make(map[TValue]TKey, len(dict))
+	for key, value := range dict {
+		if _, exists := result[value]; exists {
+			return result, &slinq.DuplicateKeyError[TValue]{Key: value}
+		}
+		result[value] = key
+	}
+	return result, nil
+}
+
+// GroupValues returns a map from the values of the provided map to all the keys that have that value.
+// The order of the keys in a group follows the random iteration order of the map.
+func GroupValues[TKey comparable, TValue comparable](dict map[TKey]TValue) map[TValue][]TKey {
+	result := make(map[TValue][]TKey)
+	for key, value := range dict {
+		result[value] = append(result[value], key)
+	}
+	return result
+}
+
+// PickKeys returns a map that contains the entries of the provided map with the provided keys, keys that don't exist are ignored.
+func PickKeys[TKey comparable, TValue any](dict map[TKey]TValue, keys ...TKey) map[TKey]TValue {
+	result := make(map[TKey]TValue, len(keys))
+	for _, key := range keys {
+		if value, exists := dict[key]; exists {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// OmitKeys returns a map that contains the entries of the provided map except for the ones with the provided keys.
+func OmitKeys[TKey comparable, TValue any](dict map[TKey]TValue, keys ...TKey) map[TKey]TValue {
+	omitted := make(map[TKey]struct{}, len(keys))
+	for _, key := range keys {
+		omitted[key] = struct{}{}
+	}
+	result := make(map[TKey]TValue, len(dict))
+	for key, value := range dict {
+		if _, exists := omitted[key]; !exists {
+			result[key] = value
+		}
+	}
+	return result
+}
+
+// MapEqual returns true when both provided maps contain the same keys with equal values. A nil map is equal to an empty map.
+func MapEqual[TKey comparable, TValue comparable](first, second map[TKey]TValue) bool {
+	return MapEqualBy(first, second, func(a, b TValue) bool { return a == b })
+}
+
+// MapEqualBy returns true when both provided maps contain the same keys and the provided comparer considers their values equal.
+func MapEqualBy[TKey comparable, TValue1 any, TValue2 any](first map[TKey]TValue1, second map[TKey]TValue2, equal func(TValue1, TValue2) bool) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for key, a := range first {
+		b, exists := second[key]
+		if !exists || !equal(a, b) {
+			return false
+		}
+	}
+	return true
+}
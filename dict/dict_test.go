@@ -0,0 +1,144 @@
+package dict
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"slinq"
+)
+
+func TestWhereMap(t *testing.T) {
+	stock := map[string]int{"apple": 3, "pear": 0, "plum": 7}
+
+	want := map[string]int{"apple": 3, "plum": 7}
+	if got := WhereMap(stock, func(_ string, n int) bool { return n > 0 }); !reflect.DeepEqual(got, want) {
+		t.Errorf("WhereMap() = %v, want %v", got, want)
+	}
+	if got := WhereMap(stock, func(string, int) bool { return false }); got == nil || len(got) != 0 {
+		t.Errorf("WhereMap() = %#v, want non-nil empty map", got)
+	}
+}
+
+func TestSelectValues(t *testing.T) {
+	prices := map[string]int{"a": 100, "b": 250}
+
+	want := map[string]float64{"a": 1, "b": 2.5}
+	if got := SelectValues(prices, func(_ string, cents int) float64 { return float64(cents) / 100 }); !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectValues() = %v, want %v", got, want)
+	}
+}
+
+func TestSelectKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		dict    map[string]int
+		want    map[string]int
+		wantErr bool
+	}{
+		{
+			name: "Should transform the keys",
+			dict: map[string]int{"a": 1, "b": 2},
+			want: map[string]int{"A": 1, "B": 2},
+		},
+		{
+			name:    "Should return error because two keys collide",
+			dict:    map[string]int{"a": 1, "A": 2},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SelectKeys(tt.dict, func(k string, _ int) string { return strings.ToUpper(k) })
+			var duplicate *slinq.DuplicateKeyError[string]
+			if errors.As(err, &duplicate) != tt.wantErr {
+				t.Errorf("SelectKeys() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SelectKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeMaps(t *testing.T) {
+	first := map[string]int{"a": 1, "b": 2}
+	second := map[string]int{"b": 3, "c": 4}
+
+	sum := slinq.MergeWith[string](func(existing, duplicate int) int { return existing + duplicate })
+	if got, err := MergeMaps(sum, first, second); err != nil || !reflect.DeepEqual(got, map[string]int{"a": 1, "b": 5, "c": 4}) {
+		t.Errorf("MergeMaps() = %v, %v", got, err)
+	}
+	if got, _ := MergeMaps(slinq.FirstWins[string, int](), first, second); got["b"] != 2 {
+		t.Errorf("MergeMaps(FirstWins) b = %v, want 2", got["b"])
+	}
+	_, err := MergeMaps(slinq.ErrorOnDuplicate[string, int](), first, second)
+	var duplicate *slinq.DuplicateKeyError[string]
+	if !errors.As(err, &duplicate) || duplicate.Key != "b" {
+		t.Errorf("MergeMaps(ErrorOnDuplicate) error = %v, want duplicate key b", err)
+	}
+}
+
+func TestInvertMap(t *testing.T) {
+	if got, err := InvertMap(map[string]int{"a": 1, "b": 2}); err != nil || !reflect.DeepEqual(got, map[int]string{1: "a", 2: "b"}) {
+		t.Errorf("InvertMap() = %v, %v", got, err)
+	}
+
+	_, err := InvertMap(map[string]int{"a": 1, "b": 1})
+	var duplicate *slinq.DuplicateKeyError[int]
+	if !errors.As(err, &duplicate) || duplicate.Key != 1 {
+		t.Errorf("InvertMap() error = %v, want duplicate key 1", err)
+	}
+}
+
+func TestGroupValues(t *testing.T) {
+	got := GroupValues(map[string]int{"a": 1, "b": 2, "c": 1})
+	for _, keys := range got {
+		sort.Strings(keys)
+	}
+
+	want := map[int][]string{1: {"a", "c"}, 2: {"b"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupValues() = %v, want %v", got, want)
+	}
+}
+
+func TestPickOmitKeys(t *testing.T) {
+	dict := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	if got, want := PickKeys(dict, "a", "c", "x"), map[string]int{"a": 1, "c": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PickKeys() = %v, want %v", got, want)
+	}
+	if got, want := OmitKeys(dict, "a", "x"), map[string]int{"b": 2, "c": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OmitKeys() = %v, want %v", got, want)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  map[string]int
+		second map[string]int
+		want   bool
+	}{
+		{name: "Should return true for equal maps", first: map[string]int{"a": 1}, second: map[string]int{"a": 1}, want: true},
+		{name: "Should return false for different values", first: map[string]int{"a": 1}, second: map[string]int{"a": 2}, want: false},
+		{name: "Should return false for different keys", first: map[string]int{"a": 0}, second: map[string]int{"b": 0}, want: false},
+		{name: "Should return true for nil and empty", first: nil, second: map[string]int{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapEqual(tt.first, tt.second); got != tt.want {
+				t.Errorf("MapEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	lengths := map[string][]int{"a": {1, 2}}
+	if !MapEqualBy(lengths, map[string]int{"a": 2}, func(s []int, n int) bool { return len(s) == n }) {
+		t.Errorf("MapEqualBy() = false, want true")
+	}
+}
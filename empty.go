@@ -0,0 +1,26 @@
+package slinq
+
+// NonNilEmpty makes every operator that returns a slice return a non-nil empty slice instead of nil when the result has no elements,
+// e.g. so that empty results are encoded as [] instead of null by encoding/json.
+// It is read on every call without synchronization, so it should only be set once during program initialization.
+var NonNilEmpty = false
+
+// NonNil returns the provided slice, or a non-nil empty slice if it is nil.
+// It applies the NonNilEmpty behaviour to a single result.
+func NonNil[T any](slice []T) []T {
+	if slice == nil {
+		return []T{}
+	}
+	return slice
+}
+
+// emptyResult applies the nil-vs-empty contract to a result that was allocated by an operator.
+func emptyResult[T any](slice []T) []T {
+	if len(slice) != 0 {
+		return slice
+	}
+	if NonNilEmpty {
+		return NonNil(slice)
+	}
+	return nil
+}
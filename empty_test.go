@@ -0,0 +1,104 @@
+package slinq
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+)
+
+// emptyResults calls every operator that returns a slice in a way that produces no elements.
+func emptyResults() map[string]func() interface{} {
+	empty := []int{}
+	identity := func(i int) int { return i }
+	never := func(int) bool { return false }
+	add := func(a, b int) int { return a + b }
+	less := func(a, b int) bool { return a < b }
+	rng := rand.New(rand.NewSource(1))
+
+	return map[string]func() interface{}{
+		"Chunk":                       func() interface{} { r, _ := Chunk(empty, 2); return r },
+		"Chunk error":                 func() interface{} { r, _ := Chunk(empty, 0); return r },
+		"Collect":                     func() interface{} { return Collect(FromSlice(empty)) },
+		"Concat":                      func() interface{} { return Concat(empty, empty) },
+		"Distinct":                    func() interface{} { return Distinct(empty) },
+		"DistinctUntilChanged":        func() interface{} { return DistinctUntilChanged(empty) },
+		"Except":                      func() interface{} { return Except([]int{1}, []int{1}) },
+		"Flatten":                     func() interface{} { return Flatten([][]int{empty}) },
+		"FlattenDeep":                 func() interface{} { return FlattenDeep([][][]int{{empty}}) },
+		"Interleave":                  func() interface{} { return Interleave(empty, empty) },
+		"Intersect":                   func() interface{} { return Intersect([]int{1}, []int{2}) },
+		"MergeSorted":                 func() interface{} { return MergeSorted(less, empty) },
+		"MergeSortedDistinct":         func() interface{} { return MergeSortedDistinct(less, empty) },
+		"Range":                       func() interface{} { return Range(0, 0) },
+		"RangeStep":                   func() interface{} { r, _ := RangeStep(1, 0, 1); return r },
+		"Repeat":                      func() interface{} { return Repeat(1, 0) },
+		"ReservoirSample":             func() interface{} { r, _ := ReservoirSample(FromSlice(empty), 1, rng); return r },
+		"Reverse":                     func() interface{} { return Reverse(empty) },
+		"RunLengthDecode":             func() interface{} { return RunLengthDecode([]Run[int]{}) },
+		"RunLengthEncode":             func() interface{} { return RunLengthEncode(empty) },
+		"Sample":                      func() interface{} { r, _ := Sample(empty, 0, rng); return r },
+		"SampleWithReplacement":       func() interface{} { r, _ := SampleWithReplacement(empty, 0, rng); return r },
+		"Select":                      func() interface{} { return Select(empty, identity) },
+		"SelectMany":                  func() interface{} { return SelectMany(empty, func(int, int) []int { return nil }) },
+		"Shuffle":                     func() interface{} { return Shuffle(empty, rng) },
+		"SortedDistinct":              func() interface{} { return SortedDistinct(empty) },
+		"SortedExcept":                func() interface{} { return SortedExcept([]int{1}, []int{1}) },
+		"SortedIntersect":             func() interface{} { return SortedIntersect([]int{1}, []int{2}) },
+		"SortedUnion":                 func() interface{} { return SortedUnion(empty, empty) },
+		"TopK":                        func() interface{} { return TopK(empty, 3, identity) },
+		"BottomK":                     func() interface{} { return BottomK([]int{1}, 0, identity) },
+		"ToSlice":                     func() interface{} { return ToSlice(map[int]int{}, add) },
+		"WeightedSample":              func() interface{} { r, _ := WeightedSample(empty, func(int) float64 { return 1 }, 0, rng); return r },
+		"Where":                       func() interface{} { return Where([]int{1}, never) },
+		"SelectIndexed":               func() interface{} { return SelectIndexed(empty, func(i, _ int) int { return i }) },
+		"TakeWhile":                   func() interface{} { return TakeWhile([]int{1}, never) },
+		"WhereIndexed":                func() interface{} { return WhereIndexed([]int{1}, func(int, int) bool { return false }) },
+		"Zip":                         func() interface{} { return Zip(empty, []int{1}, add) },
+		"DistinctUntilChangedBy":      func() interface{} { return DistinctUntilChangedBy(empty, identity) },
+		"SampleWithReplacement error": func() interface{} { r, _ := SampleWithReplacement(empty, 1, rng); return r },
+	}
+}
+
+func TestEmptyResultContract(t *testing.T) {
+	for name, call := range emptyResults() {
+		t.Run(name, func(t *testing.T) {
+			if got, _ := json.Marshal(call()); string(got) != "null" {
+				t.Errorf("%s() = %s, want nil", name, got)
+			}
+		})
+	}
+
+	NonNilEmpty = true
+	defer func() { NonNilEmpty = false }()
+	for name, call := range emptyResults() {
+		t.Run(name+" with NonNilEmpty", func(t *testing.T) {
+			if got, _ := json.Marshal(call()); string(got) != "[]" {
+				t.Errorf("%s() = %s, want non-nil empty slice", name, got)
+			}
+		})
+	}
+}
+
+func TestEmptyResultExceptions(t *testing.T) {
+	if got := ToMap([]int{}, func(i int) int { return i }, func(i int) int { return i }); got == nil {
+		t.Errorf("ToMap() = nil, want non-nil map")
+	}
+
+	dst := make([]int, 0, 4)
+	if got := WhereInto(dst, []int{1}, func(int) bool { return false }); got == nil || cap(got) != 4 {
+		t.Errorf("WhereInto() = %#v, want the provided dst", got)
+	}
+	if got := FilterInPlace(dst, func(int) bool { return false }); got == nil {
+		t.Errorf("FilterInPlace() = nil, want the shortened input")
+	}
+}
+
+func TestNonNil(t *testing.T) {
+	if got := NonNil[int](nil); got == nil || len(got) != 0 {
+		t.Errorf("NonNil() = %#v, want non-nil empty slice", got)
+	}
+	slice := []int{1}
+	if got := NonNil(slice); &got[0] != &slice[0] {
+		t.Errorf("NonNil() did not return the provided slice")
+	}
+}
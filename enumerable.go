@@ -0,0 +1,104 @@
+package slinq
+
+import (
+	"container/heap"
+	"container/list"
+	"container/ring"
+)
+
+// Enumerable is a collection that can be iterated as a sequence.
+// The operators take a Seq instead of an Enumerable, because Go can't infer T from an argument of an interface type,
+// so a collection is passed to them through From or its Seq method, and Collect turns it into a slice for the slice operators.
+//
+// Every call of Seq must return a sequence that starts from the beginning of the collection, and every iteration of the returned sequence must do so as well,
+// so that an Enumerable can be iterated more than once. Whether the collection may be modified during an iteration is up to the implementation.
+type Enumerable[T any] interface {
+	Seq() Seq[T]
+}
+
+// From returns the sequence of the provided collection, e.g. slinq.WhereSeq(slinq.From[int](tree), isLeaf).
+// It is the bridge from code that is generic over Enumerable to the operators.
+func From[T any](e Enumerable[T]) Seq[T] {
+	return e.Seq()
+}
+
+// Seq returns the sequence itself, so that every Seq is an Enumerable.
+// Sequences that consume their source, like FromChan, keep consuming it and can't start from the beginning again.
+func (s Seq[T]) Seq() Seq[T] {
+	return s
+}
+
+// Slice is a slice that is an Enumerable, converting a []T to a Slice[T] doesn't copy it.
+type Slice[T any] []T
+
+// Seq returns a sequence that yields the elements of the slice.
+func (s Slice[T]) Seq() Seq[T] {
+	return FromSlice(s)
+}
+
+// Map is a map that is an Enumerable of its key-value pairs, converting a map[TKey]TValue to a Map[TKey, TValue] doesn't copy it.
+type Map[TKey comparable, TValue any] map[TKey]TValue
+
+// Seq returns a sequence that yields the key-value pairs of the map in random order.
+func (m Map[TKey, TValue]) Seq() Seq[KeyValue[TKey, TValue]] {
+	return func(yield func(KeyValue[TKey, TValue]) bool) {
+		for key, value := range m {
+			if !yield(KeyValue[TKey, TValue]{key, value}) {
+				return
+			}
+		}
+	}
+}
+
+// FromList returns a sequence that yields the values of the provided list from front to back, all values must be of type T.
+// The element that was just yielded may be removed from the list during the iteration.
+func FromList[T any](l *list.List) Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e.Value.(T)) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// FromRing returns a sequence that yields the values of the provided ring once, starting at the provided element, all values must be of type T.
+// The ring must not be modified during the iteration.
+func FromRing[T any](r *ring.Ring) Seq[T] {
+	return func(yield func(T) bool) {
+		if r == nil {
+			return
+		}
+		for e := r; ; {
+			if !yield(e.Value.(T)) {
+				return
+			}
+			if e = e.Next(); e == r {
+				return
+			}
+		}
+	}
+}
+
+// FromHeap returns a sequence that yields the values of the provided heap in order, Pop must return values of type T.
+// The values are popped while they are yielded and pushed back once the iteration ends, so every iteration starts from the beginning
+// and the heap contains the same values afterwards, although possibly in a different internal order. The heap must not be modified during the iteration.
+func FromHeap[T any](h heap.Interface) Seq[T] {
+	return func(yield func(T) bool) {
+		var popped []T
+		defer func() {
+			for _, v := range popped {
+				heap.Push(h, v)
+			}
+		}()
+		for h.Len() > 0 {
+			v := heap.Pop(h).(T)
+			popped = append(popped, v)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package slinq
+
+import (
+	"container/heap"
+	"container/list"
+	"container/ring"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+var (
+	_ Enumerable[int]                   = Seq[int](nil)
+	_ Enumerable[int]                   = Slice[int](nil)
+	_ Enumerable[KeyValue[string, int]] = Map[string, int](nil)
+	_ Enumerable[int]                   = (*Set[int])(nil)
+	_ Enumerable[int]                   = (*OrderedSet[int])(nil)
+	_ Enumerable[KeyValue[string, int]] = (*OrderedMap[string, int])(nil)
+)
+
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(v any)        { *h = append(*h, v.(int)) }
+func (h *intHeap) Pop() any {
+	old := *h
+	v := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return v
+}
+
+func collectTwice[T any](e Enumerable[T]) ([]T, []T) {
+	return Collect(e.Seq()), Collect(e.Seq())
+}
+
+func TestEnumerable(t *testing.T) {
+	l := list.New()
+	r := ring.New(3)
+	for i := 1; i <= 3; i++ {
+		l.PushBack(i)
+		r.Value = i
+		r = r.Next()
+	}
+
+	tests := []struct {
+		name       string
+		enumerable Enumerable[int]
+		want       []int
+	}{
+		{name: "Slice", enumerable: Slice[int]{1, 2, 3}, want: []int{1, 2, 3}},
+		{name: "Seq", enumerable: TakeSeq(Iterate(1, func(v int) int { return v + 1 }), 3), want: []int{1, 2, 3}},
+		{name: "OrderedSet", enumerable: NewOrderedSet(1, 2, 3), want: []int{1, 2, 3}},
+		{name: "List", enumerable: FromList[int](l), want: []int{1, 2, 3}},
+		{name: "Ring", enumerable: FromRing[int](r.Move(1)), want: []int{2, 3, 1}},
+		{name: "Empty ring", enumerable: FromRing[int](nil), want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := collectTwice(tt.enumerable)
+			if !reflect.DeepEqual(first, tt.want) || !reflect.DeepEqual(second, tt.want) {
+				t.Errorf("Seq() = %v then %v, want %v both times", first, second, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumerableMap(t *testing.T) {
+	entries := Collect(Map[string, int]{"a": 1, "b": 2}.Seq())
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+
+	if want := []KeyValue[string, int]{{"a", 1}, {"b", 2}}; !reflect.DeepEqual(entries, want) {
+		t.Errorf("Seq() = %v, want %v", entries, want)
+	}
+}
+
+func TestFromList(t *testing.T) {
+	l := list.New()
+	for i := 1; i <= 4; i++ {
+		l.PushBack(i)
+	}
+
+	got := Collect(WhereSeq(FromList[int](l), func(v int) bool { return v%2 == 0 }))
+	if want := []int{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WhereSeq(FromList()) = %v, want %v", got, want)
+	}
+
+	FromList[int](l)(func(v int) bool {
+		for e := l.Front(); e != nil && v%2 == 0; e = e.Next() {
+			if e.Value == v {
+				l.Remove(e)
+				break
+			}
+		}
+		return true
+	})
+	if got, want := Collect(FromList[int](l)), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FromList() after removing = %v, want %v", got, want)
+	}
+}
+
+func TestFromHeap(t *testing.T) {
+	h := &intHeap{5, 2, 8, 1}
+	heap.Init(h)
+
+	if got, want := Collect(TakeSeq(FromHeap[int](h), 2)), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FromHeap() = %v, want %v", got, want)
+	}
+	if h.Len() != 4 {
+		t.Errorf("Len() = %v, want 4", h.Len())
+	}
+	first, second := collectTwice[int](FromHeap[int](h))
+	if want := []int{1, 2, 5, 8}; !reflect.DeepEqual(first, want) || !reflect.DeepEqual(second, want) {
+		t.Errorf("FromHeap() = %v then %v, want %v both times", first, second, want)
+	}
+}
+
+func TestFrom(t *testing.T) {
+	sum := func(e Enumerable[int]) int {
+		total := 0
+		From(e)(func(v int) bool {
+			total += v
+			return true
+		})
+		return total
+	}
+
+	if got := sum(NewOrderedSet(1, 2, 3)); got != 6 {
+		t.Errorf("From() summed to %v, want 6", got)
+	}
+	if got := Collect(WhereSeq(From[int](Slice[int]{1, 2, 3, 4}), func(v int) bool { return v > 2 })); !reflect.DeepEqual(got, []int{3, 4}) {
+		t.Errorf("WhereSeq(From()) = %v, want [3 4]", got)
+	}
+}
@@ -0,0 +1,162 @@
+package slinq
+
+import (
+	"errors"
+	"math"
+)
+
+// The float operators treat all NaNs as one value, unlike the == operator and map keys, for which NaN is never equal to anything.
+// Negative and positive zero are equal, like they are for the == operator, and the first occurrence decides which of them is kept.
+
+// DistinctFloat removes duplicate values from the provided slice, keeping the first occurrence of every value in its original order.
+// All NaNs are considered duplicates of each other.
+func DistinctFloat[T Float](slice []T) []T {
+	var result []T
+	seen := make(map[T]struct{}, len(slice))
+	seenNaN := false
+	for _, v := range slice {
+		if v != v {
+			if !seenNaN {
+				seenNaN = true
+				result = append(result, v)
+			}
+			continue
+		}
+		if _, exists := seen[v]; !exists {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+// ExceptFloat returns the elements of the first provided slice that don't appear in the second provided slice.
+// NaN in the first slice is removed if the second slice contains any NaN. Like Except, duplicates in the first slice are kept.
+func ExceptFloat[T Float](first, second []T) []T {
+	set, hasNaN := floatSet(second)
+	var result []T
+	for _, v := range first {
+		if v != v {
+			if !hasNaN {
+				result = append(result, v)
+			}
+		} else if _, exists := set[v]; !exists {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+// IntersectFloat returns the elements of the second provided slice that appear in the first provided slice.
+// NaN in the second slice is kept if the first slice contains any NaN. Like Intersect, duplicates in the second slice are kept.
+func IntersectFloat[T Float](first, second []T) []T {
+	set, hasNaN := floatSet(first)
+	var result []T
+	for _, v := range second {
+		if v != v {
+			if hasNaN {
+				result = append(result, v)
+			}
+		} else if _, exists := set[v]; exists {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+// FloatComparer compares floats with the == operator, except that all NaNs are equal.
+// Use it with the ...With operators, e.g. GroupByWith, to get the same NaN handling as DistinctFloat.
+func FloatComparer[T Float]() EqualityComparer[T] {
+	return NewEqualityComparer(floatEquals[T], func(value T) uint64 {
+		if value != value {
+			return math.MaxUint64
+		}
+		if value == 0 {
+			return 0
+		}
+		return math.Float64bits(float64(value))
+	})
+}
+
+// FloatEpsilonComparer considers two floats equal when they round to the same multiple of the provided epsilon, i.e. when math.Round(v / epsilon) is equal.
+// The buckets are centered on the multiples, so values that only differ from a multiple by rounding errors end up in the same bucket.
+// Unlike FloatToleranceComparer this is transitive and can be hashed, so the ...With operators stay linear.
+// Values closer than epsilon on different sides of a bucket boundary are not equal. All NaNs are equal and each infinity is its own bucket.
+// Returns an error if epsilon is not a positive finite number, because the buckets would not be well-defined.
+func FloatEpsilonComparer[T Float](epsilon T) (EqualityComparer[T], error) {
+	if !(epsilon > 0) || math.IsInf(float64(epsilon), 0) {
+		return nil, errors.New("epsilon must be a positive finite number")
+	}
+	bucket := func(v T) float64 {
+		if math.IsInf(float64(v), 0) {
+			return float64(v)
+		}
+		return math.Round(float64(v / epsilon))
+	}
+	return NewEqualityComparer(func(a, b T) bool {
+		if a != a || b != b {
+			return a != a && b != b
+		}
+		return bucket(a) == bucket(b)
+	}, func(value T) uint64 {
+		if value != value {
+			return math.MaxUint64
+		}
+		b := bucket(value)
+		if b == 0 {
+			return 0
+		}
+		return math.Float64bits(b)
+	}), nil
+}
+
+// FloatULPComparer considers two floats equal when they fall into the same bucket of the provided number of units in the last place.
+// Floats are numbered in order of their value, so that adjacent floats differ by one, and the buckets are consecutive ranges of ulps numbers.
+// Like FloatEpsilonComparer it is transitive and can be hashed, values close to a bucket boundary can end up in different buckets.
+// Negative and positive zero are in the same bucket, all NaNs are equal.
+// Returns an error if ulps is zero or greater than math.MaxInt64.
+func FloatULPComparer(ulps uint64) (EqualityComparer[float64], error) {
+	if ulps == 0 || ulps > math.MaxInt64 {
+		return nil, errors.New("ulps must be between 1 and math.MaxInt64")
+	}
+	bucket := func(v float64) int64 {
+		ordinal := int64(math.Float64bits(v))
+		if ordinal < 0 {
+			// negative floats are stored as sign and magnitude, mirror them so that the ordinals are ordered like the values
+			ordinal = math.MinInt64 - ordinal
+		}
+		b := ordinal / int64(ulps)
+		if ordinal < 0 && ordinal%int64(ulps) != 0 {
+			b--
+		}
+		return b
+	}
+	return NewEqualityComparer(func(a, b float64) bool {
+		if math.IsNaN(a) || math.IsNaN(b) {
+			return math.IsNaN(a) && math.IsNaN(b)
+		}
+		return bucket(a) == bucket(b)
+	}, func(value float64) uint64 {
+		if math.IsNaN(value) {
+			return math.MaxUint64
+		}
+		return uint64(bucket(value))
+	}), nil
+}
+
+func floatEquals[T Float](a, b T) bool {
+	return a == b || (a != a && b != b)
+}
+
+func floatSet[T Float](slice []T) (map[T]struct{}, bool) {
+	set := make(map[T]struct{}, len(slice))
+	hasNaN := false
+	for _, v := range slice {
+		if v != v {
+			hasNaN = true
+		} else {
+			set[v] = struct{}{}
+		}
+	}
+	return set, hasNaN
+}
@@ -0,0 +1,156 @@
+package slinq
+
+import (
+	"math"
+	"testing"
+)
+
+// floatsEqual compares float slices, treating all NaNs as equal and distinguishing -0 from +0.
+func floatsEqual(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if math.IsNaN(a[i]) != math.IsNaN(b[i]) || (!math.IsNaN(a[i]) && math.Float64bits(a[i]) != math.Float64bits(b[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFloatSetOperations(t *testing.T) {
+	nan := math.NaN()
+	negativeZero := math.Copysign(0, -1)
+
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{
+			name: "DistinctFloat keeps one NaN",
+			got:  DistinctFloat([]float64{1, nan, 2, nan, 1}),
+			want: []float64{1, nan, 2},
+		},
+		{
+			name: "DistinctFloat keeps the first of -0 and +0",
+			got:  DistinctFloat([]float64{negativeZero, 0, 1}),
+			want: []float64{negativeZero, 1},
+		},
+		{
+			name: "ExceptFloat removes NaN",
+			got:  ExceptFloat([]float64{1, nan, 2}, []float64{nan, 2}),
+			want: []float64{1},
+		},
+		{
+			name: "ExceptFloat keeps NaN",
+			got:  ExceptFloat([]float64{1, nan}, []float64{1}),
+			want: []float64{nan},
+		},
+		{
+			name: "ExceptFloat removes -0 for +0",
+			got:  ExceptFloat([]float64{negativeZero, 1}, []float64{0}),
+			want: []float64{1},
+		},
+		{
+			name: "IntersectFloat matches NaN",
+			got:  IntersectFloat([]float64{nan, 3}, []float64{1, nan, 3}),
+			want: []float64{nan, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !floatsEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloatComparers(t *testing.T) {
+	nan := math.NaN()
+	next := math.Nextafter(1, 2)
+
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{
+			name: "FloatComparer",
+			got:  DistinctWith([]float64{nan, 0, math.Copysign(0, -1), nan}, FloatComparer[float64]()),
+			want: []float64{nan, 0},
+		},
+		{
+			name: "FloatEpsilonComparer groups rounding errors",
+			got:  DistinctWith([]float64{0.1 + 0.2, 0.3, 0.35, 0.45}, mustComparer(FloatEpsilonComparer(0.1))),
+			want: []float64{0.1 + 0.2, 0.45},
+		},
+		{
+			name: "FloatEpsilonComparer with infinities and NaN",
+			got:  DistinctWith([]float64{math.Inf(1), math.Inf(-1), math.Inf(1), nan, nan}, mustComparer(FloatEpsilonComparer(0.1))),
+			want: []float64{math.Inf(1), math.Inf(-1), nan},
+		},
+		{
+			name: "FloatULPComparer groups adjacent floats",
+			got:  IntersectWith([]float64{1}, []float64{next, 1.5}, mustComparer(FloatULPComparer(4))),
+			want: []float64{next},
+		},
+		{
+			name: "FloatULPComparer keeps zeros together",
+			got:  DistinctWith([]float64{0, math.Copysign(0, -1), -math.SmallestNonzeroFloat64}, mustComparer(FloatULPComparer(1))),
+			want: []float64{0, -math.SmallestNonzeroFloat64},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !floatsEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloatComparersInvalidArguments(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "FloatEpsilonComparer(0)", err: comparerErr(FloatEpsilonComparer(0.0))},
+		{name: "FloatEpsilonComparer(-0.1)", err: comparerErr(FloatEpsilonComparer(-0.1))},
+		{name: "FloatEpsilonComparer(NaN)", err: comparerErr(FloatEpsilonComparer(nan))},
+		{name: "FloatEpsilonComparer(+Inf)", err: comparerErr(FloatEpsilonComparer(inf))},
+		{name: "FloatToleranceComparer(-0.1)", err: comparerErr(FloatToleranceComparer(-0.1))},
+		{name: "FloatToleranceComparer(NaN)", err: comparerErr(FloatToleranceComparer(nan))},
+		{name: "FloatToleranceComparer(+Inf)", err: comparerErr(FloatToleranceComparer(inf))},
+		{name: "FloatULPComparer(0)", err: comparerErr(FloatULPComparer(0))},
+		{name: "FloatULPComparer(MaxInt64+1)", err: comparerErr(FloatULPComparer(math.MaxInt64 + 1))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Errorf("%s error = nil, want error", tt.name)
+			}
+		})
+	}
+
+	exact := mustComparer(FloatToleranceComparer(0))
+	for _, v := range []float64{1, nan, inf, -inf} {
+		if !exact.Equals(v, v) {
+			t.Errorf("FloatToleranceComparer(0).Equals(%v, %v) = false, want true", v, v)
+		}
+	}
+}
+
+func comparerErr[T any](_ EqualityComparer[T], err error) error {
+	return err
+}
+
+func mustComparer[T any](comparer EqualityComparer[T], err error) EqualityComparer[T] {
+	if err != nil {
+		panic(err)
+	}
+	return comparer
+}
@@ -0,0 +1,186 @@
+package slinq
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"unsafe"
+)
+
+// Range generates a slice of the provided number of consecutive values, starting with the provided value.
+// For integer types the slice ends at the largest value of the type instead of wrapping around, so it has fewer than count elements then.
+// Like make, Range panics when the slice can't be allocated.
+func Range[T Number](start T, count int) []T {
+	if count < 0 {
+		count = 0
+	}
+	if T(1)/2 == 0 && count > 0 {
+		if available := integerValuesAfter(start); uint64(count-1) > available {
+			count = int(available) + 1
+		}
+	}
+	result := make([]T, count)
+	for i := range result {
+		result[i] = start + T(i)
+	}
+	return emptyResult(result)
+}
+
+// RangeStep generates a slice of values from start (inclusive) to stop (exclusive) that are step apart.
+// A negative step produces a descending slice. Each value is computed as start + i*step, so floating-point steps don't accumulate rounding errors.
+// Returns an error if step is zero, if an argument is NaN or infinite, or if the slice has more elements than can be allocated.
+func RangeStep[T Number](start, stop, step T) ([]T, error) {
+	if step == 0 {
+		return emptyResult[T](nil), errors.New("step cannot be zero")
+	}
+
+	var count int
+	var err error
+	if T(1)/2 != 0 {
+		count, err = floatRangeCount(start, stop, step)
+	} else {
+		count, err = integerRangeCount(start, stop, step)
+	}
+	if err != nil {
+		return emptyResult[T](nil), err
+	}
+
+	result, err := makeRange[T](count)
+	if err != nil {
+		return emptyResult[T](nil), err
+	}
+	for i := range result {
+		result[i] = start + T(i)*step
+	}
+	return emptyResult(result), nil
+}
+
+// makeRange allocates a slice of the provided length, or returns an error if make rejects the length as out of range.
+// Lengths that are in range but don't fit into memory still crash the program, like they do with make.
+func makeRange[T any](count int) (result []T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(runtime.Error); !ok {
+				panic(r)
+			}
+			result, err = nil, errors.New("range has too many elements")
+		}
+	}()
+	return make([]T, count), nil
+}
+
+// integerValuesAfter returns how many values of the integer type T are greater than the provided value.
+func integerValuesAfter[T Number](v T) uint64 {
+	var zero T
+	bits := 8 * uint64(unsafe.Sizeof(zero))
+	if zero-1 < zero {
+		max := uint64(1)<<(bits-1) - 1
+		return max - uint64(int64(v))
+	}
+	max := uint64(math.MaxUint64) >> (64 - bits)
+	return max - uint64(v)
+}
+
+// integerRangeCount returns the number of values of RangeStep for integer types.
+// The distance is computed in uint64, where it can't overflow, the values themselves are computed with wrapping arithmetic in T, which is exact because they are in range.
+func integerRangeCount[T Number](start, stop, step T) (int, error) {
+	var zero T
+	if (step > 0 && start >= stop) || (step < 0 && start <= stop) {
+		return 0, nil
+	}
+	var distance, magnitude uint64
+	if zero-1 < zero {
+		distance = uint64(int64(stop)) - uint64(int64(start))
+		magnitude = uint64(int64(step))
+		if step < 0 {
+			distance, magnitude = -distance, -magnitude
+		}
+	} else {
+		distance, magnitude = uint64(stop)-uint64(start), uint64(step)
+	}
+	count := distance / magnitude
+	if distance%magnitude != 0 {
+		count++
+	}
+	if count > math.MaxInt {
+		return 0, errors.New("range has too many elements")
+	}
+	return int(count), nil
+}
+
+// floatRangeCount returns the number of values of RangeStep for floating-point types.
+// The count is estimated in float64 and then corrected, so that start + count*step is the first value that is out of range.
+func floatRangeCount[T Number](start, stop, step T) (int, error) {
+	for _, v := range []T{start, stop, step} {
+		if f := float64(v); math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, errors.New("arguments must be finite")
+		}
+	}
+	inRange := func(v T) bool {
+		if step > 0 {
+			return v < stop
+		}
+		return v > stop
+	}
+	if !inRange(start) {
+		return 0, nil
+	}
+
+	estimate := math.Ceil((float64(stop) - float64(start)) / float64(step))
+	if estimate >= math.MaxInt {
+		return 0, errors.New("range has too many elements")
+	}
+	count := int(estimate)
+	for inRange(start + T(count)*step) {
+		if count == math.MaxInt {
+			return 0, errors.New("range has too many elements")
+		}
+		count++
+	}
+	for count > 0 && !inRange(start+T(count-1)*step) {
+		count--
+	}
+	return count, nil
+}
+
+// Unfold returns a sequence that is generated from the provided seed.
+// The provided generator receives the current state and returns the next element, the next state and whether the sequence continues.
+func Unfold[T any, TState any](seed TState, generator func(TState) (T, TState, bool)) Seq[T] {
+	return func(yield func(T) bool) {
+		state := seed
+		for {
+			v, next, ok := generator(state)
+			if !ok || !yield(v) {
+				return
+			}
+			state = next
+		}
+	}
+}
+
+// Iterate returns an infinite sequence of the provided seed followed by the repeated application of the provided function to it.
+func Iterate[T any](seed T, next func(T) T) Seq[T] {
+	return func(yield func(T) bool) {
+		v := seed
+		for yield(v) {
+			v = next(v)
+		}
+	}
+}
+
+// Cycle returns an infinite sequence that repeats the elements of the provided slice.
+// The sequence is empty when the provided slice is empty.
+func Cycle[T any](slice []T) Seq[T] {
+	return func(yield func(T) bool) {
+		if len(slice) == 0 {
+			return
+		}
+		for {
+			for _, v := range slice {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package slinq
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	type args struct {
+		start int
+		count int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "Should return five consecutive numbers starting at 3",
+			args: args{start: 3, count: 5},
+			want: []int{3, 4, 5, 6, 7},
+		},
+		{
+			name: "Should return nil for negative count",
+			args: args{start: 3, count: -1},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Range(tt.args.start, tt.args.count)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Range() = %v, want %v", got, tt.want)
+			}
+			if cap(got) != len(tt.want) {
+				t.Errorf("Range() cap = %v, want %v", cap(got), len(tt.want))
+			}
+		})
+	}
+
+	if got, want := Range(0.5, 3), []float64{0.5, 1.5, 2.5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range() = %v, want %v", got, want)
+	}
+}
+
+func TestRangeNarrowIntegers(t *testing.T) {
+	if got, want := Range[int8](120, 10), []int8{120, 121, 122, 123, 124, 125, 126, 127}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range() = %v, want %v", got, want)
+	}
+	if got, want := Range[uint8](254, 1<<40), []uint8{254, 255}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range() = %v, want %v", got, want)
+	}
+	if got := Range[int8](math.MinInt8, 1000); len(got) != 256 || got[255] != math.MaxInt8 {
+		t.Errorf("Range() got %v elements, want 256", len(got))
+	}
+	if got, want := Range[int64](math.MaxInt64, 3), []int64{math.MaxInt64}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range() = %v, want %v", got, want)
+	}
+}
+
+func TestRangeStep(t *testing.T) {
+	type args struct {
+		start float64
+		stop  float64
+		step  float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []float64
+		wantErr bool
+	}{
+		{
+			name: "Should not include stop value",
+			args: args{start: 0, stop: 1, step: 0.1},
+			want: []float64{0, 0.1, 0.2, 0.30000000000000004, 0.4, 0.5, 0.6000000000000001, 0.7000000000000001, 0.8, 0.9},
+		},
+		{
+			name: "Should return descending values for negative step",
+			args: args{start: 3, stop: 0, step: -1.5},
+			want: []float64{3, 1.5},
+		},
+		{
+			name: "Should return nil when start is past stop",
+			args: args{start: 3, stop: 0, step: 1},
+			want: nil,
+		},
+		{
+			name:    "Should return error because step is zero",
+			args:    args{start: 0, stop: 1, step: 0},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error because stop is infinite",
+			args:    args{start: 0, stop: math.Inf(1), step: 1},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error because stop is NaN",
+			args:    args{start: 0, stop: math.NaN(), step: 1},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error because step is NaN",
+			args:    args{start: 0, stop: 1, step: math.NaN()},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error because the range has too many elements",
+			args:    args{start: -math.MaxFloat64, stop: math.MaxFloat64, step: math.SmallestNonzeroFloat64},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error because the range can't be allocated",
+			args:    args{start: 0, stop: 1e18, step: 1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RangeStep(tt.args.start, tt.args.stop, tt.args.step)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RangeStep() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RangeStep() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got, _ := RangeStep(0, 10, 3); !reflect.DeepEqual(got, []int{0, 3, 6, 9}) {
+		t.Errorf("RangeStep() got = %v, want %v", got, []int{0, 3, 6, 9})
+	}
+}
+
+func TestRangeStepNarrowIntegers(t *testing.T) {
+	type args struct {
+		start int8
+		stop  int8
+		step  int8
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int8
+	}{
+		{
+			name: "Should not overflow when the distance doesn't fit into the type",
+			args: args{start: -100, stop: 100, step: 50},
+			want: []int8{-100, -50, 0, 50},
+		},
+		{
+			name: "Should stop when the next value would overflow",
+			args: args{start: 100, stop: 127, step: 50},
+			want: []int8{100},
+		},
+		{
+			name: "Should cover the whole type",
+			args: args{start: math.MinInt8, stop: math.MaxInt8, step: 127},
+			want: []int8{-128, -1, 126},
+		},
+		{
+			name: "Should not overflow with a negative step",
+			args: args{start: 127, stop: -128, step: -128},
+			want: []int8{127, -1},
+		},
+		{
+			name: "Should handle the smallest step",
+			args: args{start: 0, stop: -1, step: math.MinInt8},
+			want: []int8{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RangeStep(tt.args.start, tt.args.stop, tt.args.step)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RangeStep() got = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+
+	if got, err := RangeStep[uint8](10, 255, 100); err != nil || !reflect.DeepEqual(got, []uint8{10, 110, 210}) {
+		t.Errorf("RangeStep() got = %v, %v, want %v", got, err, []uint8{10, 110, 210})
+	}
+	if got, err := RangeStep[uint8](0, 255, 1); err != nil || len(got) != 255 || got[254] != 254 {
+		t.Errorf("RangeStep() got %v elements, %v, want 255", len(got), err)
+	}
+	if _, err := RangeStep[int64](0, math.MaxInt64, 1); err == nil {
+		t.Errorf("RangeStep() error = nil, want error because the range can't be allocated")
+	}
+	if got, err := RangeStep[int64](math.MinInt64, math.MaxInt64, math.MaxInt64); err != nil || !reflect.DeepEqual(got, []int64{math.MinInt64, -1, math.MaxInt64 - 1}) {
+		t.Errorf("RangeStep() got = %v, %v", got, err)
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	fibonacci := func(s [2]int) (int, [2]int, bool) {
+		return s[0], [2]int{s[1], s[0] + s[1]}, s[0] < 20
+	}
+
+	want := []int{0, 1, 1, 2, 3, 5, 8, 13}
+	if got := Collect(Unfold([2]int{0, 1}, fibonacci)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Unfold() = %v, want %v", got, want)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+
+	want := []int{1, 2, 4, 8, 16}
+	if got := Collect(TakeSeq(Iterate(1, double), 5)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterate() = %v, want %v", got, want)
+	}
+}
+
+func TestCycle(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		want  []int
+	}{
+		{
+			name:  "Should repeat the elements",
+			slice: []int{1, 2, 3},
+			want:  []int{1, 2, 3, 1, 2, 3, 1},
+		},
+		{
+			name:  "Should return empty sequence",
+			slice: []int{},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(TakeSeq(Cycle(tt.slice), 7)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Cycle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package slinq
+
+// Grouping is a key together with the elements that share it.
+type Grouping[TKey any, T any] struct {
+	Key      TKey
+	Elements []T
+}
+
+// GroupBy returns a map of the keys the provided selector returns for the elements of the provided slice to the elements with that key.
+// The elements of every group keep their original order.
+// Use GroupByOrdered to keep the groups in the order of the first occurrence of their key.
+func GroupBy[T any, TKey comparable](slice []T, keySelector func(T) TKey) map[TKey][]T {
+	dict := make(map[TKey][]T)
+	for _, v := range slice {
+		key := keySelector(v)
+		dict[key] = append(dict[key], v)
+	}
+	return dict
+}
+
+// GroupByWith groups the elements of the provided slice by the keys the provided selector returns, using the provided comparer to decide which keys are equal.
+// The groups are ordered by the first occurrence of their key, the elements of every group keep their original order.
+// The key of a group is the first key that was found for it.
+func GroupByWith[T any, TKey any](slice []T, keySelector func(T) TKey, comparer EqualityComparer[TKey]) []Grouping[TKey, T] {
+	var result []Grouping[TKey, T]
+	buckets := make(map[uint64][]int)
+	for _, v := range slice {
+		key := keySelector(v)
+		hash := comparer.Hash(key)
+		found := false
+		for _, i := range buckets[hash] {
+			if comparer.Equals(result[i].Key, key) {
+				result[i].Elements = append(result[i].Elements, v)
+				found = true
+				break
+			}
+		}
+		if !found {
+			buckets[hash] = append(buckets[hash], len(result))
+			result = append(result, Grouping[TKey, T]{key, []T{v}})
+		}
+	}
+	return emptyResult(result)
+}
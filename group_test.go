@@ -0,0 +1,36 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	want := map[int][]string{1: {"a", "b"}, 2: {"cc"}, 3: {"ddd", "eee"}}
+	if got := GroupBy([]string{"a", "cc", "ddd", "b", "eee"}, length); !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupByWith(t *testing.T) {
+	type file struct {
+		owner string
+		name  string
+	}
+	owner := func(f file) string { return f.owner }
+	files := []file{{"Bob", "a"}, {"alice", "b"}, {"bob", "c"}, {"ALICE", "d"}}
+
+	want := []Grouping[string, file]{
+		{Key: "Bob", Elements: []file{{"Bob", "a"}, {"bob", "c"}}},
+		{Key: "alice", Elements: []file{{"alice", "b"}, {"ALICE", "d"}}},
+	}
+	if got := GroupByWith(files, owner, CaseInsensitiveComparer()); !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupByWith() = %v, want %v", got, want)
+	}
+
+	if got := GroupByWith([]file{}, owner, CaseInsensitiveComparer()); got != nil {
+		t.Errorf("GroupByWith() = %v, want nil", got)
+	}
+}
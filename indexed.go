@@ -0,0 +1,199 @@
+package slinq
+
+import "errors"
+
+// The ...Indexed variants pass the zero-based position of the element in the source to the condition or selector, in addition to the element itself.
+// For sequences the position is the number of elements that came before the element.
+
+// AllIndexed returns true when all the elements in the provided slice satisfy the provided condition.
+func AllIndexed[T any](slice []T, condition func(T, int) bool) bool {
+	for i, v := range slice {
+		if !condition(v, i) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllIndexedSeq is the sequence variant of AllIndexed, it stops at the first element that doesn't satisfy the condition.
+// The provided sequence must be finite unless such an element exists.
+func AllIndexedSeq[T any](seq Seq[T], condition func(T, int) bool) bool {
+	result, i := true, 0
+	seq(func(v T) bool {
+		i++
+		result = condition(v, i-1)
+		return result
+	})
+	return result
+}
+
+// AnyIndexed returns true when at least one element in the provided slice satisfies the provided condition.
+func AnyIndexed[T any](slice []T, condition func(T, int) bool) bool {
+	for i, v := range slice {
+		if condition(v, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnyIndexedSeq is the sequence variant of AnyIndexed, it stops at the first element that satisfies the condition.
+// The provided sequence must be finite unless such an element exists.
+func AnyIndexedSeq[T any](seq Seq[T], condition func(T, int) bool) bool {
+	result, i := false, 0
+	seq(func(v T) bool {
+		i++
+		result = condition(v, i-1)
+		return !result
+	})
+	return result
+}
+
+// CountIndexed returns the count of elements in the provided slice that satisfy the provided condition.
+func CountIndexed[T any](slice []T, condition func(T, int) bool) int {
+	count := 0
+	for i, v := range slice {
+		if condition(v, i) {
+			count++
+		}
+	}
+	return count
+}
+
+// CountIndexedSeq is the sequence variant of CountIndexed. The provided sequence must be finite.
+func CountIndexedSeq[T any](seq Seq[T], condition func(T, int) bool) int {
+	count, i := 0, 0
+	seq(func(v T) bool {
+		if condition(v, i) {
+			count++
+		}
+		i++
+		return true
+	})
+	return count
+}
+
+// FindIndex returns the index of the first element of the provided slice that satisfies the provided condition, or -1 if there is none.
+func FindIndex[T any](slice []T, condition func(T) bool) int {
+	for i, v := range slice {
+		if condition(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindIndexSeq returns the position of the first element of the provided sequence that satisfies the provided condition, or -1 if there is none.
+func FindIndexSeq[T any](seq Seq[T], condition func(T) bool) int {
+	result, i := -1, 0
+	seq(func(v T) bool {
+		if condition(v) {
+			result = i
+			return false
+		}
+		i++
+		return true
+	})
+	return result
+}
+
+// FirstIndexed returns the first element of the provided slice that satisfies the provided condition.
+// Returns the zero value and an error if the slice is empty or no element satisfies the condition.
+func FirstIndexed[T any](slice []T, condition func(T, int) bool) (T, error) {
+	var zero T
+	if len(slice) == 0 {
+		return zero, errors.New("slice is empty")
+	}
+	for i, v := range slice {
+		if condition(v, i) {
+			return v, nil
+		}
+	}
+	return zero, errors.New("no element in the slice satisfies the condition")
+}
+
+// FirstIndexedSeq is the sequence variant of FirstIndexed, it stops at the first element that satisfies the condition.
+// Returns the zero value and an error if the sequence is empty or no element satisfies the condition.
+func FirstIndexedSeq[T any](seq Seq[T], condition func(T, int) bool) (T, error) {
+	var result T
+	found, i := false, 0
+	seq(func(v T) bool {
+		if condition(v, i) {
+			result, found = v, true
+			return false
+		}
+		i++
+		return true
+	})
+	if found {
+		return result, nil
+	}
+	if i == 0 {
+		return result, errors.New("sequence is empty")
+	}
+	return result, errors.New("no element in the sequence satisfies the condition")
+}
+
+// SelectIndexed returns a slice of elements of the provided slice that have been modified by the provided selector.
+func SelectIndexed[TSource any, TResult any](slice []TSource, selector func(TSource, int) TResult) []TResult {
+	result := make([]TResult, len(slice))
+	for i, v := range slice {
+		result[i] = selector(v, i)
+	}
+	return emptyResult(result)
+}
+
+// SelectIndexedSeq is the sequence variant of SelectIndexed.
+func SelectIndexedSeq[TSource any, TResult any](seq Seq[TSource], selector func(TSource, int) TResult) Seq[TResult] {
+	return func(yield func(TResult) bool) {
+		i := 0
+		seq(func(v TSource) bool {
+			i++
+			return yield(selector(v, i-1))
+		})
+	}
+}
+
+// TakeWhileIndexed returns the elements of the provided slice up to, but not including, the first element that doesn't satisfy the provided condition.
+func TakeWhileIndexed[T any](slice []T, condition func(T, int) bool) []T {
+	n := 0
+	for n < len(slice) && condition(slice[n], n) {
+		n++
+	}
+	result := make([]T, n)
+	copy(result, slice)
+	return emptyResult(result)
+}
+
+// TakeWhileIndexedSeq is the sequence variant of TakeWhileIndexed.
+func TakeWhileIndexedSeq[T any](seq Seq[T], condition func(T, int) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		seq(func(v T) bool {
+			i++
+			return condition(v, i-1) && yield(v)
+		})
+	}
+}
+
+// WhereIndexed returns a slice that contains all the elements of the provided slice that satisfy the provided condition.
+func WhereIndexed[T any](slice []T, condition func(T, int) bool) []T {
+	var result []T
+	for i, v := range slice {
+		if condition(v, i) {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+// WhereIndexedSeq is the sequence variant of WhereIndexed.
+func WhereIndexedSeq[T any](seq Seq[T], condition func(T, int) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		seq(func(v T) bool {
+			i++
+			return !condition(v, i-1) || yield(v)
+		})
+	}
+}
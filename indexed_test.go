@@ -0,0 +1,97 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndexedPredicates(t *testing.T) {
+	slice := []string{"a", "b", "c", "d", "e"}
+	evenPosition := func(_ string, i int) bool { return i%2 == 0 }
+	beforeThree := func(_ string, i int) bool { return i < 3 }
+
+	tests := []struct {
+		name string
+		got  interface{}
+		want interface{}
+	}{
+		{name: "WhereIndexed", got: WhereIndexed(slice, evenPosition), want: []string{"a", "c", "e"}},
+		{name: "WhereIndexedSeq", got: Collect(WhereIndexedSeq(FromSlice(slice), evenPosition)), want: []string{"a", "c", "e"}},
+		{name: "TakeWhileIndexed", got: TakeWhileIndexed(slice, beforeThree), want: []string{"a", "b", "c"}},
+		{name: "TakeWhileIndexedSeq", got: Collect(TakeWhileIndexedSeq(FromSlice(slice), beforeThree)), want: []string{"a", "b", "c"}},
+		{name: "CountIndexed", got: CountIndexed(slice, evenPosition), want: 3},
+		{name: "AnyIndexed", got: AnyIndexed(slice, func(s string, i int) bool { return s == "c" && i == 2 }), want: true},
+		{name: "AllIndexed", got: AllIndexed(slice, beforeThree), want: false},
+		{name: "AllIndexed on empty slice", got: AllIndexed([]string{}, beforeThree), want: true},
+		{name: "CountIndexedSeq", got: CountIndexedSeq(FromSlice(slice), evenPosition), want: 3},
+		{name: "AnyIndexedSeq", got: AnyIndexedSeq(FromSlice(slice), func(s string, i int) bool { return s == "c" && i == 2 }), want: true},
+		{name: "AnyIndexedSeq on empty sequence", got: AnyIndexedSeq(FromSlice([]string{}), evenPosition), want: false},
+		{name: "AllIndexedSeq", got: AllIndexedSeq(FromSlice(slice), beforeThree), want: false},
+		{name: "AllIndexedSeq on empty sequence", got: AllIndexedSeq(FromSlice([]string{}), beforeThree), want: true},
+		{name: "AllIndexedSeq stops on infinite sequence", got: AllIndexedSeq(Cycle(slice), beforeThree), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectIndexed(t *testing.T) {
+	selector := func(s string, i int) string { return s + string(rune('0'+i)) }
+	slice := []string{"a", "b", "c"}
+
+	want := []string{"a0", "b1", "c2"}
+	if got := SelectIndexed(slice, selector); !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectIndexed() = %v, want %v", got, want)
+	}
+	if got := Collect(SelectIndexedSeq(FromSlice(slice), selector)); !reflect.DeepEqual(got, want) {
+		t.Errorf("SelectIndexedSeq() = %v, want %v", got, want)
+	}
+}
+
+func TestFindIndex(t *testing.T) {
+	isNegative := func(i int) bool { return i < 0 }
+
+	tests := []struct {
+		name  string
+		slice []int
+		want  int
+	}{
+		{name: "Should return index of first match", slice: []int{3, -1, -2}, want: 1},
+		{name: "Should return -1", slice: []int{3, 1}, want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindIndex(tt.slice, isNegative); got != tt.want {
+				t.Errorf("FindIndex() = %v, want %v", got, tt.want)
+			}
+			if got := FindIndexSeq(FromSlice(tt.slice), isNegative); got != tt.want {
+				t.Errorf("FindIndexSeq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFirstIndexed(t *testing.T) {
+	afterFirst := func(i int, index int) bool { return index > 0 && i > 5 }
+
+	if got, err := FirstIndexed([]int{9, 1, 7, 8}, afterFirst); got != 7 || err != nil {
+		t.Errorf("FirstIndexed() = %v, %v, want 7", got, err)
+	}
+	if _, err := FirstIndexed([]int{9}, afterFirst); err == nil {
+		t.Errorf("FirstIndexed() error = nil, want error")
+	}
+
+	if got, err := FirstIndexedSeq(Cycle([]int{9, 1, 7, 8}), afterFirst); got != 7 || err != nil {
+		t.Errorf("FirstIndexedSeq() = %v, %v, want 7", got, err)
+	}
+	if _, err := FirstIndexedSeq(FromSlice([]int{9}), afterFirst); err == nil {
+		t.Errorf("FirstIndexedSeq() error = nil, want error")
+	}
+	if _, err := FirstIndexedSeq(FromSlice([]int{}), afterFirst); err == nil {
+		t.Errorf("FirstIndexedSeq() error = nil, want error for empty sequence")
+	}
+}
@@ -0,0 +1,57 @@
+package slinq
+
+// FilterInPlace keeps the elements of the provided slice that satisfy the provided condition, in their original order.
+// It reuses the backing array of the provided slice and returns the shortened slice, the provided slice must not be used afterwards.
+// The elements behind the returned slice are set to their zero value, so they can be garbage collected.
+func FilterInPlace[T any](slice []T, condition func(T) bool) []T {
+	n := 0
+	for _, v := range slice {
+		if condition(v) {
+			slice[n] = v
+			n++
+		}
+	}
+	zeroTail(slice, n)
+	return slice[:n]
+}
+
+// RemoveWhere removes the elements of the provided slice that satisfy the provided condition, keeping the order of the others.
+// It is the inverse of FilterInPlace and follows the same aliasing rules.
+func RemoveWhere[T any](slice []T, condition func(T) bool) []T {
+	return FilterInPlace(slice, func(v T) bool { return !condition(v) })
+}
+
+// ReverseInPlace reverses the order of the elements of the provided slice by modifying it.
+func ReverseInPlace[T any](slice []T) {
+	for i, j := 0, len(slice)-1; i < j; i, j = i+1, j-1 {
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+// DistinctInPlace removes duplicate values from the provided slice, keeping the first occurrence of every value in its original order.
+// It reuses the backing array of the provided slice and returns the shortened slice, the provided slice must not be used afterwards.
+// The elements behind the returned slice are set to their zero value, so they can be garbage collected.
+func DistinctInPlace[T comparable](slice []T) []T {
+	seen := make(map[T]struct{}, len(slice))
+	return FilterInPlace(slice, func(v T) bool {
+		if _, exists := seen[v]; exists {
+			return false
+		}
+		seen[v] = struct{}{}
+		return true
+	})
+}
+
+// MapInPlace replaces every element of the provided slice with the result of the provided selector by modifying it.
+func MapInPlace[T any](slice []T, selector func(T) T) {
+	for i, v := range slice {
+		slice[i] = selector(v)
+	}
+}
+
+func zeroTail[T any](slice []T, from int) {
+	var zero T
+	for i := from; i < len(slice); i++ {
+		slice[i] = zero
+	}
+}
@@ -0,0 +1,92 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterInPlace(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	tests := []struct {
+		name      string
+		slice     []int
+		want      []int
+		wantArray []int
+	}{
+		{
+			name:      "Should keep the order and zero the tail",
+			slice:     []int{1, 2, 3, 4, 5, 6},
+			want:      []int{2, 4, 6},
+			wantArray: []int{2, 4, 6, 0, 0, 0},
+		},
+		{
+			name:      "Should return empty slice",
+			slice:     []int{1, 3},
+			want:      []int{},
+			wantArray: []int{0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			array := tt.slice
+			got := FilterInPlace(tt.slice, isEven)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterInPlace() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(array, tt.wantArray) {
+				t.Errorf("FilterInPlace() backing array = %v, want %v", array, tt.wantArray)
+			}
+		})
+	}
+}
+
+func TestRemoveWhere(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	want := []int{1, 3, 5}
+	if got := RemoveWhere([]int{1, 2, 3, 4, 5, 6}, isEven); !reflect.DeepEqual(got, want) {
+		t.Errorf("RemoveWhere() = %v, want %v", got, want)
+	}
+}
+
+func TestReverseInPlace(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		want  []int
+	}{
+		{name: "Should reverse odd length", slice: []int{1, 2, 3, 4, 5}, want: []int{5, 4, 3, 2, 1}},
+		{name: "Should reverse even length", slice: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+		{name: "Should leave empty slice", slice: []int{}, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ReverseInPlace(tt.slice)
+			if !reflect.DeepEqual(tt.slice, tt.want) {
+				t.Errorf("ReverseInPlace() = %v, want %v", tt.slice, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistinctInPlace(t *testing.T) {
+	slice := []string{"b", "a", "b", "c", "a"}
+
+	want := []string{"b", "a", "c"}
+	if got := DistinctInPlace(slice); !reflect.DeepEqual(got, want) {
+		t.Errorf("DistinctInPlace() = %v, want %v", got, want)
+	}
+	if want := []string{"b", "a", "c", "", ""}; !reflect.DeepEqual(slice, want) {
+		t.Errorf("DistinctInPlace() backing array = %v, want %v", slice, want)
+	}
+}
+
+func TestMapInPlace(t *testing.T) {
+	slice := []int{1, 2, 3}
+	MapInPlace(slice, func(i int) int { return i * i })
+
+	if want := []int{1, 4, 9}; !reflect.DeepEqual(slice, want) {
+		t.Errorf("MapInPlace() = %v, want %v", slice, want)
+	}
+}
@@ -0,0 +1,15 @@
+// Package fold contains the case folding that the case-insensitive comparers of slinq and slinq/compare share.
+package fold
+
+import "unicode"
+
+// Rune returns the smallest rune that is equivalent to the provided rune under simple case folding, which is the folding strings.EqualFold uses.
+func Rune(r rune) rune {
+	result := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < result {
+			result = f
+		}
+	}
+	return result
+}
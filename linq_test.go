@@ -0,0 +1,63 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestLINQEdgeCases checks the edge cases that the .NET documentation specifies for the LINQ counterparts of the operators.
+// Where slinq deliberately differs, e.g. by returning an error instead of throwing, the test documents the slinq behaviour.
+func TestLINQEdgeCases(t *testing.T) {
+	empty := []int{}
+	isEven := func(i int) bool { return i%2 == 0 }
+	add := func(a, b int) int { return a + b }
+
+	tests := []struct {
+		name string
+		got  edgeCaseResult
+		want edgeCaseResult
+	}{
+		{name: "Aggregate returns the seed for an empty slice", got: edgeCaseResult{Aggregate(empty, 7, add), false}, want: edgeCaseResult{7, false}},
+		{name: "All is vacuously true for an empty slice", got: edgeCaseResult{All(empty, isEven), false}, want: edgeCaseResult{true, false}},
+		{name: "All is false when one element fails", got: edgeCaseResult{All([]int{2, 3}, isEven), false}, want: edgeCaseResult{false, false}},
+		{name: "AllNonEmpty is false for an empty slice", got: edgeCaseResult{AllNonEmpty(empty, isEven), false}, want: edgeCaseResult{false, false}},
+		{name: "AllNonEmpty is true when all elements satisfy", got: edgeCaseResult{AllNonEmpty([]int{2, 4}, isEven), false}, want: edgeCaseResult{true, false}},
+		{name: "Any is false for an empty slice", got: edgeCaseResult{Any(empty, isEven), false}, want: edgeCaseResult{false, false}},
+		{name: "Count is zero for an empty slice", got: edgeCaseResult{Count(empty, isEven), false}, want: edgeCaseResult{0, false}},
+		{name: "First fails for an empty slice", got: resultOf(First(empty, isEven)), want: edgeCaseResult{0, true}},
+		{name: "First fails when no element matches", got: resultOf(First([]int{1, 3}, isEven)), want: edgeCaseResult{0, true}},
+		{name: "First returns the first match", got: resultOf(First([]int{1, 4, 6}, isEven)), want: edgeCaseResult{4, false}},
+		{name: "Single fails for an empty slice", got: resultOf(Single(empty, isEven)), want: edgeCaseResult{0, true}},
+		{name: "Single fails when no element matches", got: resultOf(Single([]int{1, 3}, isEven)), want: edgeCaseResult{0, true}},
+		{name: "Single fails when more than one element matches", got: resultOf(Single([]int{2, 4}, isEven)), want: edgeCaseResult{0, true}},
+		{name: "Single returns the only match", got: resultOf(Single([]int{1, 4}, isEven)), want: edgeCaseResult{4, false}},
+		{name: "Chunk fails for a size of zero", got: resultOf(Chunk([]int{1}, 0)), want: edgeCaseResult{[][]int(nil), true}},
+		{name: "Chunk fails for a negative size", got: resultOf(Chunk([]int{1}, -1)), want: edgeCaseResult{[][]int(nil), true}},
+		{name: "Chunk keeps a shorter last chunk", got: resultOf(Chunk([]int{1, 2, 3}, 2)), want: edgeCaseResult{[][]int{{1, 2}, {3}}, false}},
+		{name: "Zip stops at the shorter slice", got: edgeCaseResult{Zip([]int{1, 2, 3}, []int{10}, add), false}, want: edgeCaseResult{[]int{11}, false}},
+		{name: "Repeat returns nothing for a count of zero", got: edgeCaseResult{Repeat(1, 0), false}, want: edgeCaseResult{[]int(nil), false}},
+		{name: "Repeat returns nothing for a negative count", got: edgeCaseResult{Repeat(1, -1), false}, want: edgeCaseResult{[]int(nil), false}},
+		{name: "Reverse of an empty slice is empty", got: edgeCaseResult{Reverse(empty), false}, want: edgeCaseResult{[]int(nil), false}},
+		{name: "Except with an empty second slice returns the first", got: edgeCaseResult{Except([]int{1, 2}, empty), false}, want: edgeCaseResult{[]int{1, 2}, false}},
+		{name: "Intersect with an empty slice is empty", got: edgeCaseResult{Intersect([]int{1, 2}, empty), false}, want: edgeCaseResult{[]int(nil), false}},
+		{name: "Concat of nothing is empty", got: edgeCaseResult{Concat[int](), false}, want: edgeCaseResult{[]int(nil), false}},
+		{name: "SelectMany of an empty slice is empty", got: edgeCaseResult{SelectMany(empty, func(i, _ int) []int { return []int{i} }), false}, want: edgeCaseResult{[]int(nil), false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// edgeCaseResult is the value an operator returned and whether it returned an error.
+type edgeCaseResult struct {
+	value interface{}
+	err   bool
+}
+
+func resultOf[T any](value T, err error) edgeCaseResult {
+	return edgeCaseResult{value, err != nil}
+}
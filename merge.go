@@ -0,0 +1,109 @@
+package slinq
+
+// MergeSorted merges the provided slices, which must each be sorted according to less, into one sorted slice.
+// The merge is stable: equal elements keep their order within an input, and elements of earlier inputs come before equal elements of later inputs.
+func MergeSorted[T any](less func(T, T) bool, inputs ...[]T) []T {
+	length := 0
+	for _, input := range inputs {
+		length += len(input)
+	}
+	result := make([]T, 0, length)
+	mergeSorted(less, false, sliceIterators(inputs), func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return emptyResult(result)
+}
+
+// MergeSortedDistinct merges the provided sorted slices like MergeSorted, but keeps only the first of a run of equal elements.
+// Two elements are equal when neither is less than the other.
+func MergeSortedDistinct[T any](less func(T, T) bool, inputs ...[]T) []T {
+	var result []T
+	mergeSorted(less, true, sliceIterators(inputs), func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return emptyResult(result)
+}
+
+// MergeSortedSeq is the sequence variant of MergeSorted.
+// Each input is only advanced when its current element has been yielded, so the inputs are never buffered.
+// Every input runs in its own goroutine while the merged sequence is iterated and hands over each element through channels,
+// which costs noticeably more per element than MergeSorted. A panic in an input is raised again in the goroutine that iterates the merged sequence.
+func MergeSortedSeq[T any](less func(T, T) bool, inputs ...Seq[T]) Seq[T] {
+	return mergeSortedSeq(less, false, inputs)
+}
+
+// MergeSortedDistinctSeq is the sequence variant of MergeSortedDistinct.
+func MergeSortedDistinctSeq[T any](less func(T, T) bool, inputs ...Seq[T]) Seq[T] {
+	return mergeSortedSeq(less, true, inputs)
+}
+
+func mergeSortedSeq[T any](less func(T, T) bool, distinct bool, inputs []Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		iterators := make([]func() (T, bool), len(inputs))
+		for i, input := range inputs {
+			next, stop := pull(input)
+			defer stop()
+			iterators[i] = next
+		}
+		mergeSorted(less, distinct, iterators, yield)
+	}
+}
+
+func sliceIterators[T any](inputs [][]T) []func() (T, bool) {
+	iterators := make([]func() (T, bool), len(inputs))
+	for i, input := range inputs {
+		input := input
+		position := 0
+		iterators[i] = func() (T, bool) {
+			if position == len(input) {
+				var zero T
+				return zero, false
+			}
+			position++
+			return input[position-1], true
+		}
+	}
+	return iterators
+}
+
+// mergeSorted yields the elements of the provided iterators in sorted order, using a heap that holds the current element of every iterator.
+func mergeSorted[T any](less func(T, T) bool, distinct bool, iterators []func() (T, bool), yield func(T) bool) {
+	type head struct {
+		value T
+		input int
+	}
+	queue := NewPriorityQueue(func(a, b head) bool {
+		if less(a.value, b.value) {
+			return true
+		}
+		if less(b.value, a.value) {
+			return false
+		}
+		return a.input < b.input
+	})
+	queue.items = make([]head, 0, len(iterators))
+	for i, next := range iterators {
+		if v, ok := next(); ok {
+			queue.Push(head{v, i})
+		}
+	}
+
+	var last T
+	first := true
+	for queue.Len() > 0 {
+		h, _ := queue.Peek()
+		if !distinct || first || less(last, h.value) || less(h.value, last) {
+			if !yield(h.value) {
+				return
+			}
+			last, first = h.value, false
+		}
+		if v, ok := iterators[h.input](); ok {
+			queue.replaceFront(head{v, h.input})
+		} else {
+			queue.Pop()
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package slinq
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestMergeSorted(t *testing.T) {
+	type entry struct {
+		time  int
+		shard string
+	}
+	less := func(a, b entry) bool { return a.time < b.time }
+
+	tests := []struct {
+		name   string
+		inputs [][]entry
+		want   []entry
+	}{
+		{
+			name: "Should merge stably across inputs",
+			inputs: [][]entry{
+				{{1, "a"}, {3, "a"}, {3, "a2"}},
+				{{2, "b"}, {3, "b"}},
+				{},
+				{{0, "d"}, {4, "d"}},
+			},
+			want: []entry{{0, "d"}, {1, "a"}, {2, "b"}, {3, "a"}, {3, "a2"}, {3, "b"}, {4, "d"}},
+		},
+		{
+			name:   "Should return nil",
+			inputs: nil,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeSorted(less, tt.inputs...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSorted() = %v, want %v", got, tt.want)
+			}
+			seqs := make([]Seq[entry], len(tt.inputs))
+			for i, input := range tt.inputs {
+				seqs[i] = FromSlice(input)
+			}
+			if got := Collect(MergeSortedSeq(less, seqs...)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSortedSeq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeSortedDistinct(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	want := []int{1, 2, 3, 4, 5}
+	if got := MergeSortedDistinct(less, []int{1, 2, 2, 4}, []int{2, 3, 5}, []int{1, 5}); !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSortedDistinct() = %v, want %v", got, want)
+	}
+	if got := Collect(MergeSortedDistinctSeq(less, FromSlice([]int{1, 2, 2, 4}), FromSlice([]int{2, 3, 5}))); !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSortedDistinctSeq() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSortedSeqPullsOnDemand(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	pulled := 0
+	counting := func(seq Seq[int]) Seq[int] {
+		return func(yield func(int) bool) {
+			seq(func(v int) bool {
+				pulled++
+				return yield(v)
+			})
+		}
+	}
+	evens := counting(Iterate(0, func(i int) int { return i + 2 }))
+	odds := counting(Iterate(1, func(i int) int { return i + 2 }))
+
+	want := []int{0, 1, 2, 3, 4}
+	if got := Collect(TakeSeq(MergeSortedSeq(less, evens, odds), 5)); !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSortedSeq() = %v, want %v", got, want)
+	}
+	if pulled > 7 {
+		t.Errorf("MergeSortedSeq() pulled %v elements, want at most 7", pulled)
+	}
+}
+
+func TestMergeSortedSeqPanic(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	failing := Seq[int](func(yield func(int) bool) {
+		if yield(2) {
+			panic("input failed")
+		}
+	})
+
+	var got []int
+	func() {
+		defer func() {
+			if r := recover(); r != "input failed" {
+				t.Errorf("recover() = %v, want input failed", r)
+			}
+		}()
+		MergeSortedSeq(func(a, b int) bool { return a < b }, FromSlice([]int{1, 3, 5}), failing)(func(v int) bool {
+			got = append(got, v)
+			return true
+		})
+	}()
+
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSortedSeq() = %v, want %v", got, want)
+	}
+	checkGoroutines(t, goroutines)
+}
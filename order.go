@@ -0,0 +1,66 @@
+package slinq
+
+import (
+	"errors"
+	"sort"
+)
+
+// OrderBy returns a slice with the elements of the provided slice sorted ascending by the keys the provided selector returns.
+// The sort is stable, elements with equal keys keep their original order. The provided slice is never modified.
+func OrderBy[T any, TKey Ordered](slice []T, keySelector func(T) TKey) []T {
+	return orderBy(slice, func(a, b T) bool { return keySelector(a) < keySelector(b) })
+}
+
+// OrderByDescending returns a slice with the elements of the provided slice sorted descending by the keys the provided selector returns.
+// The sort is stable, elements with equal keys keep their original order. The provided slice is never modified.
+func OrderByDescending[T any, TKey Ordered](slice []T, keySelector func(T) TKey) []T {
+	return orderBy(slice, func(a, b T) bool { return keySelector(b) < keySelector(a) })
+}
+
+// OrderByWith returns a slice with the elements of the provided slice sorted according to the provided comparer.
+// The sort is stable, elements that the comparer considers equal keep their original order. The provided slice is never modified.
+func OrderByWith[T any](slice []T, comparer Comparer[T]) []T {
+	return orderBy(slice, func(a, b T) bool { return comparer.Compare(a, b) < 0 })
+}
+
+// MinBy returns the first element of the provided slice with the smallest key, returns an error if the slice is empty.
+func MinBy[T any, TKey Ordered](slice []T, keySelector func(T) TKey) (T, error) {
+	return best(slice, func(a, b T) bool { return keySelector(a) < keySelector(b) })
+}
+
+// MaxBy returns the first element of the provided slice with the largest key, returns an error if the slice is empty.
+func MaxBy[T any, TKey Ordered](slice []T, keySelector func(T) TKey) (T, error) {
+	return best(slice, func(a, b T) bool { return keySelector(b) < keySelector(a) })
+}
+
+// MinByWith returns the first element of the provided slice that comes first according to the provided comparer, returns an error if the slice is empty.
+func MinByWith[T any](slice []T, comparer Comparer[T]) (T, error) {
+	return best(slice, func(a, b T) bool { return comparer.Compare(a, b) < 0 })
+}
+
+// MaxByWith returns the first element of the provided slice that comes last according to the provided comparer, returns an error if the slice is empty.
+func MaxByWith[T any](slice []T, comparer Comparer[T]) (T, error) {
+	return best(slice, func(a, b T) bool { return comparer.Compare(a, b) > 0 })
+}
+
+func orderBy[T any](slice []T, less func(T, T) bool) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+	sort.SliceStable(result, func(i, j int) bool { return less(result[i], result[j]) })
+	return emptyResult(result)
+}
+
+// best returns the first element of the slice that no other element is better than.
+func best[T any](slice []T, better func(T, T) bool) (T, error) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, errors.New("slice is empty")
+	}
+	result := slice[0]
+	for _, v := range slice[1:] {
+		if better(v, result) {
+			result = v
+		}
+	}
+	return result, nil
+}
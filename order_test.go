@@ -0,0 +1,63 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrderBy(t *testing.T) {
+	type item struct {
+		name     string
+		priority int
+	}
+	priority := func(i item) int { return i.priority }
+	slice := []item{{"a", 2}, {"b", 1}, {"c", 2}, {"d", 0}}
+
+	if got, want := OrderBy(slice, priority), []item{{"d", 0}, {"b", 1}, {"a", 2}, {"c", 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderBy() = %v, want %v", got, want)
+	}
+	if got, want := OrderByDescending(slice, priority), []item{{"a", 2}, {"c", 2}, {"b", 1}, {"d", 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderByDescending() = %v, want %v", got, want)
+	}
+	if slice[0].name != "a" || slice[3].name != "d" {
+		t.Errorf("OrderBy() modified the provided slice: %v", slice)
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	slice := []string{"bb", "a", "ccc", "d", "eee"}
+
+	tests := []struct {
+		name    string
+		got     string
+		err     error
+		want    string
+		wantErr bool
+	}{
+		{name: "MinBy returns the first smallest", want: "a"},
+		{name: "MaxBy returns the first largest", want: "ccc"},
+		{name: "MinBy fails for empty slice", wantErr: true},
+	}
+	tests[0].got, tests[0].err = MinBy(slice, length)
+	tests[1].got, tests[1].err = MaxBy(slice, length)
+	tests[2].got, tests[2].err = MinBy([]string{}, length)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.err != nil) != tt.wantErr || tt.got != tt.want {
+				t.Errorf("got %v, %v, want %v, error %v", tt.got, tt.err, tt.want, tt.wantErr)
+			}
+		})
+	}
+
+	var byLength Comparer[string] = ComparerFunc[string](func(a, b string) int { return len(a) - len(b) })
+	if got, _ := MinByWith(slice, byLength); got != "a" {
+		t.Errorf("MinByWith() = %v, want a", got)
+	}
+	if got := OrderByWith(slice, byLength); !reflect.DeepEqual(got, []string{"a", "d", "bb", "ccc", "eee"}) {
+		t.Errorf("OrderByWith() = %v", got)
+	}
+	if !IsSortedWith([]string{"a", "bb", "cc"}, byLength) || IsSortedWith([]string{"bb", "a"}, byLength) {
+		t.Errorf("IsSortedWith() did not use the provided comparer")
+	}
+}
@@ -0,0 +1,210 @@
+package slinq
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap is a map that remembers the order in which its keys were first set, the zero value is an empty map that is ready to use.
+// Set, Get and Delete take constant time, setting a key that already exists keeps its position.
+type OrderedMap[TKey comparable, TValue any] struct {
+	entries     map[TKey]*orderedMapEntry[TKey, TValue]
+	first, last *orderedMapEntry[TKey, TValue]
+}
+
+type orderedMapEntry[TKey comparable, TValue any] struct {
+	key        TKey
+	value      TValue
+	prev, next *orderedMapEntry[TKey, TValue]
+	// deleted entries keep their links, so that an iteration that holds one can find its way back into the list.
+	deleted bool
+}
+
+// NewOrderedMap returns an empty ordered map.
+func NewOrderedMap[TKey comparable, TValue any]() *OrderedMap[TKey, TValue] {
+	return &OrderedMap[TKey, TValue]{}
+}
+
+// ToOrderedMap returns an ordered map that was created by applying the provided key and value selectors to the elements of the provided slice.
+// The keys are in the order of their first occurrence, like ToMap the value of the last element with a key wins.
+func ToOrderedMap[T any, TKey comparable, TValue any](slice []T, keySelector func(T) TKey, valueSelector func(T) TValue) *OrderedMap[TKey, TValue] {
+	dict := &OrderedMap[TKey, TValue]{entries: make(map[TKey]*orderedMapEntry[TKey, TValue], len(slice))}
+	for _, v := range slice {
+		dict.Set(keySelector(v), valueSelector(v))
+	}
+	return dict
+}
+
+// GroupByOrdered is GroupBy that returns the groups in the order of the first occurrence of their key.
+// The elements of every group keep their original order.
+func GroupByOrdered[T any, TKey comparable](slice []T, keySelector func(T) TKey) *OrderedMap[TKey, []T] {
+	dict := &OrderedMap[TKey, []T]{entries: make(map[TKey]*orderedMapEntry[TKey, []T])}
+	for _, v := range slice {
+		key := keySelector(v)
+		if entry, exists := dict.entries[key]; exists {
+			entry.value = append(entry.value, v)
+		} else {
+			dict.Set(key, []T{v})
+		}
+	}
+	return dict
+}
+
+// Len returns the number of entries in the map.
+func (m *OrderedMap[TKey, TValue]) Len() int {
+	return len(m.entries)
+}
+
+// Set sets the value of the provided key, a new key is added at the end of the map.
+func (m *OrderedMap[TKey, TValue]) Set(key TKey, value TValue) {
+	if entry, exists := m.entries[key]; exists {
+		entry.value = value
+		return
+	}
+	if m.entries == nil {
+		m.entries = make(map[TKey]*orderedMapEntry[TKey, TValue])
+	}
+	entry := &orderedMapEntry[TKey, TValue]{key: key, value: value, prev: m.last}
+	if m.last == nil {
+		m.first = entry
+	} else {
+		m.last.next = entry
+	}
+	m.last = entry
+	m.entries[key] = entry
+}
+
+// Get returns the value of the provided key and true, or the zero value and false if the map doesn't contain the key.
+func (m *OrderedMap[TKey, TValue]) Get(key TKey) (TValue, bool) {
+	if entry, exists := m.entries[key]; exists {
+		return entry.value, true
+	}
+	var zero TValue
+	return zero, false
+}
+
+// Delete removes the provided key from the map and returns true if it was contained.
+func (m *OrderedMap[TKey, TValue]) Delete(key TKey) bool {
+	entry, exists := m.entries[key]
+	if !exists {
+		return false
+	}
+	delete(m.entries, key)
+	if entry.prev == nil {
+		m.first = entry.next
+	} else {
+		entry.prev.next = entry.next
+	}
+	if entry.next == nil {
+		m.last = entry.prev
+	} else {
+		entry.next.prev = entry.prev
+	}
+	entry.deleted = true
+	return true
+}
+
+// Keys returns the keys of the map in order.
+func (m *OrderedMap[TKey, TValue]) Keys() []TKey {
+	result := make([]TKey, 0, len(m.entries))
+	for entry := m.first; entry != nil; entry = entry.next {
+		result = append(result, entry.key)
+	}
+	return emptyResult(result)
+}
+
+// Values returns the values of the map in the order of their keys.
+func (m *OrderedMap[TKey, TValue]) Values() []TValue {
+	result := make([]TValue, 0, len(m.entries))
+	for entry := m.first; entry != nil; entry = entry.next {
+		result = append(result, entry.value)
+	}
+	return emptyResult(result)
+}
+
+// Entries returns the key-value pairs of the map in order.
+func (m *OrderedMap[TKey, TValue]) Entries() []KeyValue[TKey, TValue] {
+	result := make([]KeyValue[TKey, TValue], 0, len(m.entries))
+	for entry := m.first; entry != nil; entry = entry.next {
+		result = append(result, KeyValue[TKey, TValue]{entry.key, entry.value})
+	}
+	return emptyResult(result)
+}
+
+// Seq returns a sequence that yields the key-value pairs of the map in order.
+// The map may be modified during the iteration: entries that are deleted before they are reached are skipped, and entries that are added are yielded as well.
+func (m *OrderedMap[TKey, TValue]) Seq() Seq[KeyValue[TKey, TValue]] {
+	return func(yield func(KeyValue[TKey, TValue]) bool) {
+		for entry := m.first; entry != nil; entry = m.after(entry) {
+			if !yield(KeyValue[TKey, TValue]{entry.key, entry.value}) {
+				return
+			}
+		}
+	}
+}
+
+// after returns the entry that follows the provided entry, which may have been deleted in the meantime.
+// The prev link of a deleted entry leads to the entry that was before it when it was deleted, following them ends at the closest entry that is still in the list.
+// Entries are only added at the end, so the entry after that one has not been reached yet.
+func (m *OrderedMap[TKey, TValue]) after(entry *orderedMapEntry[TKey, TValue]) *orderedMapEntry[TKey, TValue] {
+	for entry.deleted {
+		if entry = entry.prev; entry == nil {
+			return m.first
+		}
+	}
+	return entry.next
+}
+
+// MarshalJSON encodes the map as a JSON object with the keys in order.
+// Keys are encoded like encoding/json encodes map keys, so they must be strings, integers or implement encoding.TextMarshaler.
+// It has a value receiver, so that an OrderedMap that is stored by value, e.g. in a struct field, is encoded as well.
+func (m OrderedMap[TKey, TValue]) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for entry := m.first; entry != nil; entry = entry.next {
+		if entry != m.first {
+			buffer.WriteByte(',')
+		}
+		key, err := jsonKey(entry.key)
+		if err != nil {
+			return nil, err
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(entry.value)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(encodedKey)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// jsonKey returns the string that encoding/json uses for the provided map key.
+func jsonKey(key any) (string, error) {
+	value := reflect.ValueOf(key)
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	}
+	if marshaler, ok := key.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported map key type: %T", key)
+}
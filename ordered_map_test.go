@@ -0,0 +1,200 @@
+package slinq
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+	var dict OrderedMap[string, int]
+	dict.Set("c", 1)
+	dict.Set("a", 2)
+	dict.Set("b", 3)
+	dict.Set("c", 4)
+
+	if got, want := dict.Keys(), []string{"c", "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if got, want := dict.Values(), []int{4, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if value, ok := dict.Get("a"); value != 2 || !ok {
+		t.Errorf("Get(a) = %v, %v, want 2, true", value, ok)
+	}
+	if value, ok := dict.Get("x"); value != 0 || ok {
+		t.Errorf("Get(x) = %v, %v, want 0, false", value, ok)
+	}
+
+	if !dict.Delete("a") || dict.Delete("a") {
+		t.Errorf("Delete(a) should only return true the first time")
+	}
+	dict.Delete("b")
+	dict.Set("a", 5)
+	if got, want := dict.Entries(), []KeyValue[string, int]{{"c", 4}, {"a", 5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+	if dict.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", dict.Len())
+	}
+	if got := NewOrderedMap[string, int]().Keys(); got != nil {
+		t.Errorf("Keys() = %#v, want nil", got)
+	}
+}
+
+func TestOrderedMapSeq(t *testing.T) {
+	dict := ToOrderedMap([]int{1, 2, 3, 4}, identity[int], func(v int) int { return v * v })
+
+	var keys []int
+	dict.Seq()(func(entry KeyValue[int, int]) bool {
+		keys = append(keys, entry.Key)
+		if entry.Key%2 == 0 {
+			dict.Delete(entry.Key)
+		}
+		return true
+	})
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Seq() = %v, want %v", keys, want)
+	}
+	if got, want := dict.Keys(), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if got := Collect(TakeSeq(dict.Seq(), 1)); len(got) != 1 || got[0].Value != 1 {
+		t.Errorf("TakeSeq(Seq(), 1) = %v, want [{1 1}]", got)
+	}
+}
+
+func TestOrderedMapSeqModifications(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(dict *OrderedMap[string, int], key string)
+		want   []string
+	}{
+		{
+			name: "Should skip the following entry when it is deleted",
+			modify: func(dict *OrderedMap[string, int], key string) {
+				if key == "a" {
+					dict.Delete("a")
+					dict.Delete("b")
+				}
+			},
+			want: []string{"a", "c"},
+		},
+		{
+			name: "Should skip entries that are deleted before they are reached",
+			modify: func(dict *OrderedMap[string, int], key string) {
+				if key == "a" {
+					dict.Delete("b")
+					dict.Delete("c")
+				}
+			},
+			want: []string{"a"},
+		},
+		{
+			name: "Should yield entries that are added after the last entry was deleted",
+			modify: func(dict *OrderedMap[string, int], key string) {
+				if key == "c" {
+					dict.Delete("c")
+					dict.Delete("b")
+					dict.Set("d", 4)
+				}
+			},
+			want: []string{"a", "b", "c", "d"},
+		},
+		{
+			name: "Should yield a deleted key again when it is added again",
+			modify: func(dict *OrderedMap[string, int], key string) {
+				if key == "b" {
+					dict.Delete("a")
+					dict.Delete("b")
+					dict.Set("a", 1)
+				}
+			},
+			want: []string{"a", "b", "c", "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dict := ToOrderedMap([]string{"a", "b", "c"}, identity[string], func(string) int { return 0 })
+			var got []string
+			dict.Seq()(func(entry KeyValue[string, int]) bool {
+				got = append(got, entry.Key)
+				tt.modify(dict, entry.Key)
+				return true
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Seq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	words := []string{"bb", "a", "cc", "d", "eee"}
+	got := GroupByOrdered(words, func(w string) int { return len(w) })
+
+	want := []KeyValue[int, []string]{{2, []string{"bb", "cc"}}, {1, []string{"a", "d"}}, {3, []string{"eee"}}}
+	if !reflect.DeepEqual(got.Entries(), want) {
+		t.Errorf("GroupByOrdered() = %v, want %v", got.Entries(), want)
+	}
+}
+
+type jsonKeyType struct{ id int }
+
+func (k jsonKeyType) MarshalText() ([]byte, error) {
+	return []byte("key-" + string(rune('0'+k.id))), nil
+}
+
+func TestOrderedMapMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		dict json.Marshaler
+		want string
+	}{
+		{
+			name: "Should keep the order of string keys",
+			dict: ToOrderedMap([]string{"z", "a", "m"}, identity[string], func(s string) int { return len(s) }),
+			want: `{"z":1,"a":1,"m":1}`,
+		},
+		{
+			name: "Should quote integer keys",
+			dict: ToOrderedMap([]int{3, 1}, identity[int], func(v int) []int { return []int{v} }),
+			want: `{"3":[3],"1":[1]}`,
+		},
+		{
+			name: "Should use MarshalText for keys",
+			dict: ToOrderedMap([]int{2, 1}, func(v int) jsonKeyType { return jsonKeyType{v} }, identity[int]),
+			want: `{"key-2":2,"key-1":1}`,
+		},
+		{
+			name: "Should encode an empty map as an empty object",
+			dict: NewOrderedMap[string, int](),
+			want: `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.dict)
+			if err != nil || string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := json.Marshal(ToOrderedMap([]float64{1}, identity[float64], identity[float64])); err == nil {
+		t.Errorf("MarshalJSON() error = nil, want error for float keys")
+	}
+}
+
+func TestOrderedMapMarshalJSONByValue(t *testing.T) {
+	var wrapper struct {
+		M OrderedMap[string, int]
+	}
+	wrapper.M.Set("b", 1)
+	wrapper.M.Set("a", 2)
+
+	got, err := json.Marshal(wrapper)
+	if want := `{"M":{"b":1,"a":2}}`; err != nil || string(got) != want {
+		t.Errorf("json.Marshal() = %s, %v, want %s", got, err, want)
+	}
+}
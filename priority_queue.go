@@ -0,0 +1,87 @@
+package slinq
+
+import "errors"
+
+// PriorityQueue is a binary heap that orders its elements with the provided comparator.
+// The element that is ordered first by the comparator is always at the front of the queue.
+type PriorityQueue[T any] struct {
+	items []T
+	less  func(T, T) bool
+}
+
+// NewPriorityQueue returns an empty priority queue that puts a before b when less(a, b) is true.
+func NewPriorityQueue[T any](less func(T, T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// Len returns the number of elements in the queue.
+func (q *PriorityQueue[T]) Len() int {
+	return len(q.items)
+}
+
+// Push adds the provided element to the queue.
+func (q *PriorityQueue[T]) Push(value T) {
+	q.items = append(q.items, value)
+	q.up(len(q.items) - 1)
+}
+
+// Peek returns the element at the front of the queue without removing it, returns an error if the queue is empty.
+func (q *PriorityQueue[T]) Peek() (T, error) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, errors.New("queue is empty")
+	}
+	return q.items[0], nil
+}
+
+// Pop removes and returns the element at the front of the queue, returns an error if the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, error) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, errors.New("queue is empty")
+	}
+
+	last := len(q.items) - 1
+	result := q.items[0]
+	q.items[0] = q.items[last]
+	var zero T
+	q.items[last] = zero
+	q.items = q.items[:last]
+	q.down(0)
+	return result, nil
+}
+
+// replaceFront overwrites the element at the front of the queue and restores the heap order.
+func (q *PriorityQueue[T]) replaceFront(value T) {
+	q.items[0] = value
+	q.down(0)
+}
+
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.items[i], q.items[parent]) {
+			return
+		}
+		q.items[i], q.items[parent] = q.items[parent], q.items[i]
+		i = parent
+	}
+}
+
+func (q *PriorityQueue[T]) down(i int) {
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < len(q.items) && q.less(q.items[left], q.items[smallest]) {
+			smallest = left
+		}
+		if right < len(q.items) && q.less(q.items[right], q.items[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		q.items[i], q.items[smallest] = q.items[smallest], q.items[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,36 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	queue := NewPriorityQueue(func(a, b int) bool { return a < b })
+	for _, v := range []int{5, 1, 4, 1, 3, 9, 2} {
+		queue.Push(v)
+	}
+
+	if got, err := queue.Peek(); got != 1 || err != nil {
+		t.Errorf("Peek() = %v, %v, want 1", got, err)
+	}
+	if got := queue.Len(); got != 7 {
+		t.Errorf("Len() = %v, want 7", got)
+	}
+
+	var got []int
+	for queue.Len() > 0 {
+		v, _ := queue.Pop()
+		got = append(got, v)
+	}
+	if want := []int{1, 1, 2, 3, 4, 5, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop() order = %v, want %v", got, want)
+	}
+
+	if _, err := queue.Pop(); err == nil {
+		t.Errorf("Pop() error = nil, want error because queue is empty")
+	}
+	if _, err := queue.Peek(); err == nil {
+		t.Errorf("Peek() error = nil, want error because queue is empty")
+	}
+}
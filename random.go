@@ -0,0 +1,121 @@
+package slinq
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Shuffle returns a slice with the elements of the provided slice in random order, using the provided random number generator.
+// The provided slice is never modified. The result only depends on the state of the generator, so a seeded generator produces reproducible results.
+func Shuffle[T any](slice []T, rng *rand.Rand) []T {
+	result := make([]T, len(slice))
+	copy(result, slice)
+	for i := len(result) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		result[i], result[j] = result[j], result[i]
+	}
+	return emptyResult(result)
+}
+
+// Sample returns k elements of the provided slice that were chosen at random without replacement, using the provided random number generator.
+// Returns an error if k is negative or greater than the length of the provided slice.
+func Sample[T any](slice []T, k int, rng *rand.Rand) ([]T, error) {
+	if k < 0 {
+		return emptyResult[T](nil), errors.New("k cannot be negative")
+	}
+	if k > len(slice) {
+		return emptyResult[T](nil), errors.New("k cannot be greater than the length of the slice")
+	}
+
+	pool := make([]T, len(slice))
+	copy(pool, slice)
+	for i := 0; i < k; i++ {
+		j := i + rng.Intn(len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return emptyResult(pool[:k:k]), nil
+}
+
+// SampleWithReplacement returns k elements of the provided slice that were chosen at random with replacement, using the provided random number generator.
+// Returns an error if k is negative or if the provided slice is empty and k is not zero.
+func SampleWithReplacement[T any](slice []T, k int, rng *rand.Rand) ([]T, error) {
+	if k < 0 {
+		return emptyResult[T](nil), errors.New("k cannot be negative")
+	}
+	if k > 0 && len(slice) == 0 {
+		return emptyResult[T](nil), errors.New("slice is empty")
+	}
+
+	result := make([]T, k)
+	for i := range result {
+		result[i] = slice[rng.Intn(len(slice))]
+	}
+	return emptyResult(result), nil
+}
+
+// WeightedSample returns k elements of the provided slice that were chosen at random without replacement, using the provided random number generator.
+// The chance of an element to be chosen is proportional to the weight the provided selector returns for it, elements with a weight of zero are never chosen.
+// Returns an error if k is negative, if a weight is negative or NaN, or if fewer than k elements have a positive weight.
+func WeightedSample[T any](slice []T, weightSelector func(T) float64, k int, rng *rand.Rand) ([]T, error) {
+	if k < 0 {
+		return emptyResult[T](nil), errors.New("k cannot be negative")
+	}
+
+	type candidate struct {
+		index int
+		key   float64
+	}
+	candidates := make([]candidate, 0, len(slice))
+	for i, v := range slice {
+		weight := weightSelector(v)
+		if weight < 0 || math.IsNaN(weight) {
+			return emptyResult[T](nil), errors.New("weight cannot be negative or NaN")
+		}
+		if weight == 0 {
+			continue
+		}
+		// Efraimidis-Spirakis: picking the k largest keys u^(1/w) is a weighted sample without replacement.
+		// The logarithm of the key is used since it preserves the order and does not underflow for small weights.
+		candidates = append(candidates, candidate{i, math.Log(1-rng.Float64()) / weight})
+	}
+	if k > len(candidates) {
+		return emptyResult[T](nil), errors.New("k cannot be greater than the number of elements with a positive weight")
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].key > candidates[j].key
+	})
+	result := make([]T, k)
+	for i := range result {
+		result[i] = slice[candidates[i].index]
+	}
+	return emptyResult(result), nil
+}
+
+// ReservoirSample returns k elements of the provided sequence that were chosen at random without replacement, using the provided random number generator.
+// The sequence is iterated once and only k elements are kept in memory, so it works for sequences of unknown length.
+// Returns fewer than k elements if the sequence has fewer than k elements and an error if k is negative.
+// The sequence must be finite unless k is zero, in which case it is not iterated at all.
+func ReservoirSample[T any](seq Seq[T], k int, rng *rand.Rand) ([]T, error) {
+	if k < 0 {
+		return emptyResult[T](nil), errors.New("k cannot be negative")
+	}
+	if k == 0 {
+		return emptyResult[T](nil), nil
+	}
+
+	result := make([]T, 0, k)
+	seen := 0
+	seq(func(v T) bool {
+		seen++
+		if len(result) < k {
+			result = append(result, v)
+		} else if j := rng.Intn(seen); j < k {
+			result[j] = v
+		}
+		return true
+	})
+	return emptyResult(result), nil
+}
@@ -0,0 +1,125 @@
+package slinq
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	slice := Range(0, 20)
+
+	got := Shuffle(slice, rand.New(rand.NewSource(42)))
+	if again := Shuffle(slice, rand.New(rand.NewSource(42))); !reflect.DeepEqual(got, again) {
+		t.Errorf("Shuffle() = %v, want reproducible result %v", again, got)
+	}
+	if !reflect.DeepEqual(slice, Range(0, 20)) {
+		t.Errorf("Shuffle() modified the provided slice: %v", slice)
+	}
+
+	sorted := append([]int(nil), got...)
+	sort.Ints(sorted)
+	if !reflect.DeepEqual(sorted, slice) {
+		t.Errorf("Shuffle() = %v, want a permutation of %v", got, slice)
+	}
+}
+
+func TestSample(t *testing.T) {
+	type args struct {
+		slice []int
+		k     int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name:    "Should return 5 distinct elements",
+			args:    args{slice: Range(0, 10), k: 5},
+			wantLen: 5,
+		},
+		{
+			name:    "Should return all elements",
+			args:    args{slice: Range(0, 10), k: 10},
+			wantLen: 10,
+		},
+		{
+			name:    "Should return error because k is too large",
+			args:    args{slice: Range(0, 10), k: 11},
+			wantErr: true,
+		},
+		{
+			name:    "Should return error because k is negative",
+			args:    args{slice: Range(0, 10), k: -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Sample(tt.args.slice, tt.args.k, rand.New(rand.NewSource(1)))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sample() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantLen || len(Distinct(got)) != tt.wantLen {
+				t.Errorf("Sample() = %v, want %v distinct elements", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestSampleWithReplacement(t *testing.T) {
+	got, err := SampleWithReplacement([]string{"a", "b"}, 50, rand.New(rand.NewSource(1)))
+	if err != nil || len(got) != 50 {
+		t.Errorf("SampleWithReplacement() = %v, %v, want 50 elements", got, err)
+	}
+	if _, err := SampleWithReplacement([]string{}, 1, rand.New(rand.NewSource(1))); err == nil {
+		t.Errorf("SampleWithReplacement() error = nil, want error for empty slice")
+	}
+}
+
+func TestWeightedSample(t *testing.T) {
+	type item struct {
+		name   string
+		weight float64
+	}
+	weight := func(i item) float64 { return i.weight }
+	items := []item{{"never", 0}, {"heavy", 1000}, {"light", 0.001}, {"also-never", 0}}
+
+	got, err := WeightedSample(items, weight, 2, rand.New(rand.NewSource(7)))
+	if err != nil {
+		t.Fatalf("WeightedSample() error = %v", err)
+	}
+	if want := []item{{"heavy", 1000}, {"light", 0.001}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WeightedSample() = %v, want %v", got, want)
+	}
+
+	if _, err := WeightedSample(items, weight, 3, rand.New(rand.NewSource(7))); err == nil {
+		t.Errorf("WeightedSample() error = nil, want error because only two weights are positive")
+	}
+	if _, err := WeightedSample([]item{{"negative", -1}}, weight, 1, rand.New(rand.NewSource(7))); err == nil {
+		t.Errorf("WeightedSample() error = nil, want error for negative weight")
+	}
+}
+
+func TestReservoirSample(t *testing.T) {
+	seq := TakeSeq(Iterate(0, func(i int) int { return i + 1 }), 1000)
+
+	got, err := ReservoirSample(seq, 10, rand.New(rand.NewSource(3)))
+	if err != nil || len(Distinct(got)) != 10 {
+		t.Errorf("ReservoirSample() = %v, %v, want 10 distinct elements", got, err)
+	}
+	if again, _ := ReservoirSample(seq, 10, rand.New(rand.NewSource(3))); !reflect.DeepEqual(got, again) {
+		t.Errorf("ReservoirSample() = %v, want reproducible result %v", again, got)
+	}
+
+	if got, _ := ReservoirSample(FromSlice([]int{1, 2}), 5, rand.New(rand.NewSource(3))); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("ReservoirSample() = %v, want %v", got, []int{1, 2})
+	}
+	if got, err := ReservoirSample(Cycle([]int{1, 2}), 0, rand.New(rand.NewSource(3))); err != nil || got != nil {
+		t.Errorf("ReservoirSample() = %v, %v, want nil without iterating the infinite sequence", got, err)
+	}
+}
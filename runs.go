@@ -0,0 +1,115 @@
+package slinq
+
+// Run is a value together with the number of times it occurs consecutively.
+type Run[T any] struct {
+	Value T
+	Count int
+}
+
+// DistinctUntilChanged returns the elements of the provided slice, omitting elements that are equal to their predecessor.
+func DistinctUntilChanged[T comparable](slice []T) []T {
+	return DistinctUntilChangedBy(slice, func(v T) T { return v })
+}
+
+// DistinctUntilChangedBy returns the elements of the provided slice, omitting elements whose key is equal to the key of their predecessor.
+func DistinctUntilChangedBy[T any, TKey comparable](slice []T, keySelector func(T) TKey) []T {
+	var result []T
+	var last TKey
+	for i, v := range slice {
+		key := keySelector(v)
+		if i == 0 || key != last {
+			result = append(result, v)
+		}
+		last = key
+	}
+	return emptyResult(result)
+}
+
+// DistinctUntilChangedSeq is the sequence variant of DistinctUntilChanged.
+func DistinctUntilChangedSeq[T comparable](seq Seq[T]) Seq[T] {
+	return DistinctUntilChangedBySeq(seq, func(v T) T { return v })
+}
+
+// DistinctUntilChangedBySeq is the sequence variant of DistinctUntilChangedBy.
+func DistinctUntilChangedBySeq[T any, TKey comparable](seq Seq[T], keySelector func(T) TKey) Seq[T] {
+	return func(yield func(T) bool) {
+		var last TKey
+		first := true
+		seq(func(v T) bool {
+			key := keySelector(v)
+			if !first && key == last {
+				return true
+			}
+			first, last = false, key
+			return yield(v)
+		})
+	}
+}
+
+// RunLengthEncode returns the runs of consecutive equal elements of the provided slice.
+func RunLengthEncode[T comparable](slice []T) []Run[T] {
+	var result []Run[T]
+	for i, v := range slice {
+		if i > 0 && v == slice[i-1] {
+			result[len(result)-1].Count++
+		} else {
+			result = append(result, Run[T]{v, 1})
+		}
+	}
+	return emptyResult(result)
+}
+
+// RunLengthDecode returns a slice that contains the value of every provided run as many times as its count.
+// Runs with a count of zero or less are skipped.
+func RunLengthDecode[T any](runs []Run[T]) []T {
+	length := 0
+	for _, run := range runs {
+		if run.Count > 0 {
+			length += run.Count
+		}
+	}
+	result := make([]T, 0, length)
+	for _, run := range runs {
+		for i := 0; i < run.Count; i++ {
+			result = append(result, run.Value)
+		}
+	}
+	return emptyResult(result)
+}
+
+// RunLengthEncodeSeq is the sequence variant of RunLengthEncode. A run is yielded as soon as the next different element arrives.
+func RunLengthEncodeSeq[T comparable](seq Seq[T]) Seq[Run[T]] {
+	return func(yield func(Run[T]) bool) {
+		var current Run[T]
+		stopped := false
+		seq(func(v T) bool {
+			if current.Count > 0 && v == current.Value {
+				current.Count++
+				return true
+			}
+			if current.Count > 0 && !yield(current) {
+				stopped = true
+				return false
+			}
+			current = Run[T]{v, 1}
+			return true
+		})
+		if !stopped && current.Count > 0 {
+			yield(current)
+		}
+	}
+}
+
+// RunLengthDecodeSeq is the sequence variant of RunLengthDecode.
+func RunLengthDecodeSeq[T any](seq Seq[Run[T]]) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(run Run[T]) bool {
+			for i := 0; i < run.Count; i++ {
+				if !yield(run.Value) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
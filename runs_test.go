@@ -0,0 +1,70 @@
+package slinq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDistinctUntilChanged(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []string
+		want  []string
+	}{
+		{
+			name:  "Should collapse consecutive repeats only",
+			slice: []string{"on", "on", "off", "off", "off", "on", "on"},
+			want:  []string{"on", "off", "on"},
+		},
+		{
+			name:  "Should return nil for empty slice",
+			slice: []string{},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistinctUntilChanged(tt.slice); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DistinctUntilChanged() = %v, want %v", got, tt.want)
+			}
+			if got := Collect(DistinctUntilChangedSeq(FromSlice(tt.slice))); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DistinctUntilChangedSeq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistinctUntilChangedBy(t *testing.T) {
+	slice := []string{"a", "A", "b", "B", "b", "a"}
+
+	want := []string{"a", "b", "a"}
+	if got := DistinctUntilChangedBy(slice, strings.ToLower); !reflect.DeepEqual(got, want) {
+		t.Errorf("DistinctUntilChangedBy() = %v, want %v", got, want)
+	}
+	if got := Collect(DistinctUntilChangedBySeq(FromSlice(slice), strings.ToLower)); !reflect.DeepEqual(got, want) {
+		t.Errorf("DistinctUntilChangedBySeq() = %v, want %v", got, want)
+	}
+}
+
+func TestRunLengthEncode(t *testing.T) {
+	slice := []rune("aaabccdddd")
+	want := []Run[rune]{{'a', 3}, {'b', 1}, {'c', 2}, {'d', 4}}
+
+	if got := RunLengthEncode(slice); !reflect.DeepEqual(got, want) {
+		t.Errorf("RunLengthEncode() = %v, want %v", got, want)
+	}
+	if got := Collect(RunLengthEncodeSeq(FromSlice(slice))); !reflect.DeepEqual(got, want) {
+		t.Errorf("RunLengthEncodeSeq() = %v, want %v", got, want)
+	}
+	if got := Collect(TakeSeq(RunLengthEncodeSeq(FromSlice(slice)), 2)); !reflect.DeepEqual(got, want[:2]) {
+		t.Errorf("RunLengthEncodeSeq() = %v, want %v", got, want[:2])
+	}
+
+	if got := RunLengthDecode(want); !reflect.DeepEqual(got, slice) {
+		t.Errorf("RunLengthDecode() = %v, want %v", got, slice)
+	}
+	if got := Collect(RunLengthDecodeSeq(FromSlice(want))); !reflect.DeepEqual(got, slice) {
+		t.Errorf("RunLengthDecodeSeq() = %v, want %v", got, slice)
+	}
+}
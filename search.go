@@ -0,0 +1,121 @@
+package slinq
+
+// Contains returns true when the provided slice contains the provided value.
+func Contains[T comparable](slice []T, value T) bool {
+	return IndexOf(slice, value) >= 0
+}
+
+// ContainsBy returns true when the provided slice contains an element that the provided comparer considers equal to the provided value.
+func ContainsBy[T any](slice []T, value T, equal func(T, T) bool) bool {
+	for _, v := range slice {
+		if equal(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexOf returns the index of the first occurrence of the provided value in the provided slice, or -1 if it doesn't occur.
+func IndexOf[T comparable](slice []T, value T) int {
+	for i, v := range slice {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last occurrence of the provided value in the provided slice, or -1 if it doesn't occur.
+func LastIndexOf[T comparable](slice []T, value T) int {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if slice[i] == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLastIndex returns the index of the last element of the provided slice that satisfies the provided condition, or -1 if there is none.
+func FindLastIndex[T any](slice []T, condition func(T) bool) int {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if condition(slice[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindAll returns the indices of all the elements of the provided slice that satisfy the provided condition, in ascending order.
+func FindAll[T any](slice []T, condition func(T) bool) []int {
+	var result []int
+	for i, v := range slice {
+		if condition(v) {
+			result = append(result, i)
+		}
+	}
+	return emptyResult(result)
+}
+
+// SequenceEqual returns true when both provided slices have the same length and equal elements at every index.
+func SequenceEqual[T comparable](first, second []T) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SequenceEqualBy returns true when both provided slices have the same length and the provided comparer considers the elements at every index equal.
+func SequenceEqualBy[T1 any, T2 any](first []T1, second []T2, equal func(T1, T2) bool) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	for i := range first {
+		if !equal(first[i], second[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetEqual returns true when both provided slices contain the same distinct values, regardless of order and number of occurrences.
+func SetEqual[T comparable](first, second []T) bool {
+	dict := make(map[T]bool, len(first))
+	for _, v := range first {
+		dict[v] = false
+	}
+	for _, v := range second {
+		if _, exists := dict[v]; !exists {
+			return false
+		}
+		dict[v] = true
+	}
+	for _, seen := range dict {
+		if !seen {
+			return false
+		}
+	}
+	return true
+}
+
+// BagEqual returns true when both provided slices contain the same values the same number of times, regardless of order.
+func BagEqual[T comparable](first, second []T) bool {
+	if len(first) != len(second) {
+		return false
+	}
+	counts := make(map[T]int, len(first))
+	for _, v := range first {
+		counts[v]++
+	}
+	for _, v := range second {
+		if counts[v] == 0 {
+			return false
+		}
+		counts[v]--
+	}
+	return true
+}
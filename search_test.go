@@ -0,0 +1,106 @@
+package slinq
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	slice := []string{"Go", "Rust", "C#"}
+
+	if !Contains(slice, "Rust") || Contains(slice, "rust") {
+		t.Errorf("Contains() did not compare exactly")
+	}
+	if !ContainsBy(slice, "rust", strings.EqualFold) || ContainsBy(slice, "java", strings.EqualFold) {
+		t.Errorf("ContainsBy() did not use the provided comparer")
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	slice := []int{4, 2, 4, 7}
+
+	tests := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{name: "IndexOf", got: IndexOf(slice, 4), want: 0},
+		{name: "IndexOf missing", got: IndexOf(slice, 5), want: -1},
+		{name: "LastIndexOf", got: LastIndexOf(slice, 4), want: 2},
+		{name: "LastIndexOf missing", got: LastIndexOf(slice, 5), want: -1},
+		{name: "FindLastIndex", got: FindLastIndex(slice, func(i int) bool { return i < 5 }), want: 2},
+		{name: "FindLastIndex missing", got: FindLastIndex(slice, func(i int) bool { return i > 7 }), want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	want := []int{0, 1, 2}
+	if got := FindAll([]int{4, 2, 4, 7}, isEven); !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %v, want %v", got, want)
+	}
+	if got := FindAll([]int{1, 3}, isEven); got != nil {
+		t.Errorf("FindAll() = %v, want nil", got)
+	}
+}
+
+func TestSequenceEqual(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  []int
+		second []int
+		want   bool
+	}{
+		{name: "Should return true for equal slices", first: []int{1, 2, 3}, second: []int{1, 2, 3}, want: true},
+		{name: "Should return false for different order", first: []int{1, 2, 3}, second: []int{3, 2, 1}, want: false},
+		{name: "Should return false for different length", first: []int{1, 2}, second: []int{1, 2, 3}, want: false},
+		{name: "Should return true for empty slices", first: nil, second: []int{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SequenceEqual(tt.first, tt.second); got != tt.want {
+				t.Errorf("SequenceEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	lengthMatches := func(s string, i int) bool { return len(s) == i }
+	if !SequenceEqualBy([]string{"a", "bb"}, []int{1, 2}, lengthMatches) {
+		t.Errorf("SequenceEqualBy() = false, want true")
+	}
+}
+
+func TestSetEqual(t *testing.T) {
+	tests := []struct {
+		name    string
+		first   []int
+		second  []int
+		wantSet bool
+		wantBag bool
+	}{
+		{name: "Same elements in different order", first: []int{1, 2, 3}, second: []int{3, 1, 2}, wantSet: true, wantBag: true},
+		{name: "Same elements with different counts", first: []int{1, 1, 2}, second: []int{1, 2, 2}, wantSet: true, wantBag: false},
+		{name: "Duplicates on one side", first: []int{1, 2}, second: []int{2, 1, 1}, wantSet: true, wantBag: false},
+		{name: "Different elements", first: []int{1, 2}, second: []int{1, 3}, wantSet: false, wantBag: false},
+		{name: "Subset", first: []int{1, 2}, second: []int{1}, wantSet: false, wantBag: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SetEqual(tt.first, tt.second); got != tt.wantSet {
+				t.Errorf("SetEqual() = %v, want %v", got, tt.wantSet)
+			}
+			if got := BagEqual(tt.first, tt.second); got != tt.wantBag {
+				t.Errorf("BagEqual() = %v, want %v", got, tt.wantBag)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package slinq
+
+// Seq is a lazily evaluated sequence of values.
+// Calling it pushes the values of the sequence to yield one after another until the sequence is exhausted or yield returns false.
+// A Seq can be iterated multiple times, every iteration starts from the beginning of the sequence.
+type Seq[T any] func(yield func(T) bool)
+
+// FromSlice returns a sequence that yields the elements of the provided slice.
+func FromSlice[T any](slice []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range slice {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns a slice that contains all the elements of the provided sequence.
+// The provided sequence must be finite.
+func Collect[T any](seq Seq[T]) []T {
+	var result []T
+	seq(func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	return emptyResult(result)
+}
+
+// TakeSeq returns a sequence that yields at most the provided number of elements of the provided sequence.
+func TakeSeq[T any](seq Seq[T], count int) Seq[T] {
+	return func(yield func(T) bool) {
+		if count <= 0 {
+			return
+		}
+		taken := 0
+		seq(func(v T) bool {
+			taken++
+			return yield(v) && taken < count
+		})
+	}
+}
+
+// SelectSeq returns a sequence of the elements of the provided sequence that have been modified by the provided selector.
+func SelectSeq[TSource any, TResult any](seq Seq[TSource], selector func(TSource) TResult) Seq[TResult] {
+	return func(yield func(TResult) bool) {
+		seq(func(v TSource) bool {
+			return yield(selector(v))
+		})
+	}
+}
+
+// TakeWhileSeq returns a sequence of the elements of the provided sequence up to, but not including, the first element that doesn't satisfy the provided condition.
+func TakeWhileSeq[T any](seq Seq[T], condition func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			return condition(v) && yield(v)
+		})
+	}
+}
+
+// WhereSeq returns a sequence of the elements of the provided sequence that satisfy the provided condition.
+func WhereSeq[T any](seq Seq[T], condition func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			return !condition(v) || yield(v)
+		})
+	}
+}
+
+// pull converts the provided sequence into a function that returns the next element on every call and whether there was one.
+// The sequence runs in a separate goroutine, the returned stop function must be called to release it once the caller is done.
+// A panic of the sequence is recovered in that goroutine and raised again by the next call of next or stop, so it reaches the caller.
+func pull[T any](seq Seq[T]) (next func() (T, bool), stop func()) {
+	requests := make(chan struct{})
+	values := make(chan T)
+	done := make(chan struct{})
+
+	wait := func() bool {
+		select {
+		case <-requests:
+			return true
+		case <-done:
+			return false
+		}
+	}
+	var panicValue any
+	panicked := false
+	go func() {
+		defer close(values)
+		defer func() {
+			if r := recover(); r != nil {
+				panicValue, panicked = r, true
+			}
+		}()
+		if !wait() {
+			return
+		}
+		seq(func(v T) bool {
+			select {
+			case values <- v:
+			case <-done:
+				return false
+			}
+			return wait()
+		})
+	}()
+
+	reported := false
+	repanic := func() {
+		if panicked && !reported {
+			reported = true
+			panic(panicValue)
+		}
+	}
+	next = func() (T, bool) {
+		var v T
+		var ok bool
+		select {
+		case requests <- struct{}{}:
+			v, ok = <-values
+		case v, ok = <-values:
+		}
+		if !ok {
+			repanic()
+		}
+		return v, ok
+	}
+	stopped := false
+	stop = func() {
+		if stopped {
+			return
+		}
+		stopped = true
+		close(done)
+		for range values {
+		}
+		repanic()
+	}
+	return next, stop
+}
@@ -0,0 +1,63 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTakeSeq(t *testing.T) {
+	type args struct {
+		slice []int
+		count int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "Should take the first two elements",
+			args: args{slice: []int{1, 2, 3}, count: 2},
+			want: []int{1, 2},
+		},
+		{
+			name: "Should take all elements when count exceeds length",
+			args: args{slice: []int{1, 2, 3}, count: 10},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "Should take nothing",
+			args: args{slice: []int{1, 2, 3}, count: 0},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Collect(TakeSeq(FromSlice(tt.args.slice), tt.args.count)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TakeSeq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeqOperators(t *testing.T) {
+	seq := FromSlice([]int{1, 2, 3, 4, 5, 1})
+	isSmall := func(i int) bool { return i < 4 }
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{name: "WhereSeq", got: Collect(WhereSeq(seq, isSmall)), want: []int{1, 2, 3, 1}},
+		{name: "SelectSeq", got: Collect(SelectSeq(seq, func(i int) int { return i * 10 })), want: []int{10, 20, 30, 40, 50, 10}},
+		{name: "TakeWhileSeq", got: Collect(TakeWhileSeq(seq, isSmall)), want: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
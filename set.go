@@ -0,0 +1,323 @@
+package slinq
+
+// Set is an unordered collection of distinct values, the zero value is an empty set that is ready to use.
+// Operations that combine sets never modify the sets involved and return a new set instead.
+// Like a map key, NaN is not equal to itself: every added NaN is a separate value that counts towards Len, Contains(NaN) is false and Remove(NaN) removes nothing.
+// Use DistinctFloat before ToSet to get a single NaN.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet returns a set that contains the provided values.
+func NewSet[T comparable](values ...T) *Set[T] {
+	return ToSet(values)
+}
+
+// ToSet returns a set that contains the distinct elements of the provided slice.
+func ToSet[T comparable](slice []T) *Set[T] {
+	set := &Set[T]{make(map[T]struct{}, len(slice))}
+	for _, v := range slice {
+		set.items[v] = struct{}{}
+	}
+	return set
+}
+
+// SetFromSeq returns a set that contains the distinct elements of the provided sequence.
+// The provided sequence must be finite.
+func SetFromSeq[T comparable](seq Seq[T]) *Set[T] {
+	set := &Set[T]{make(map[T]struct{})}
+	seq(func(v T) bool {
+		set.items[v] = struct{}{}
+		return true
+	})
+	return set
+}
+
+// Len returns the number of values in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Add adds the provided values to the set.
+func (s *Set[T]) Add(values ...T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{}, len(values))
+	}
+	for _, v := range values {
+		s.items[v] = struct{}{}
+	}
+}
+
+// Remove removes the provided values from the set, values that are not contained are ignored.
+func (s *Set[T]) Remove(values ...T) {
+	for _, v := range values {
+		delete(s.items, v)
+	}
+}
+
+// Contains returns true when the set contains the provided value.
+func (s *Set[T]) Contains(value T) bool {
+	_, contains := s.items[value]
+	return contains
+}
+
+// ToSlice returns a slice that contains the values of the set in random order.
+func (s *Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s.items))
+	for v := range s.items {
+		result = append(result, v)
+	}
+	return emptyResult(result)
+}
+
+// Seq returns a sequence that yields the values of the set in random order.
+// Values that are added or removed during the iteration may or may not be yielded.
+func (s *Set[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.items {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Union returns a set that contains the values that are in this or the other set.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := &Set[T]{make(map[T]struct{}, len(s.items)+len(other.items))}
+	for v := range s.items {
+		result.items[v] = struct{}{}
+	}
+	for v := range other.items {
+		result.items[v] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a set that contains the values that are in both this and the other set.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	smaller, larger := s, other
+	if len(smaller.items) > len(larger.items) {
+		smaller, larger = larger, smaller
+	}
+	result := &Set[T]{make(map[T]struct{})}
+	for v := range smaller.items {
+		if larger.Contains(v) {
+			result.items[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a set that contains the values of this set that are not in the other set.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := &Set[T]{make(map[T]struct{})}
+	for v := range s.items {
+		if !other.Contains(v) {
+			result.items[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a set that contains the values that are in exactly one of this and the other set.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := s.Difference(other)
+	for v := range other.items {
+		if !s.Contains(v) {
+			result.items[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset returns true when every value of this set is also in the other set.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if len(s.items) > len(other.items) {
+		return false
+	}
+	for v := range s.items {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true when every value of the other set is also in this set.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true when both sets contain the same values.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return len(s.items) == len(other.items) && s.IsSubset(other)
+}
+
+// OrderedSet is a collection of distinct values that remembers the order in which they were first added, the zero value is an empty set that is ready to use.
+// Adding a value that is already contained doesn't change its position.
+// Removing a value takes linear time, all the other operations take the same time as the operations of Set.
+// NaN values behave like in Set: each one is kept separately and can't be found or removed.
+type OrderedSet[T comparable] struct {
+	index  map[T]int
+	values []T
+}
+
+// NewOrderedSet returns an ordered set that contains the provided values in the order of their first occurrence.
+func NewOrderedSet[T comparable](values ...T) *OrderedSet[T] {
+	return ToOrderedSet(values)
+}
+
+// ToOrderedSet returns an ordered set that contains the distinct elements of the provided slice in the order of their first occurrence.
+func ToOrderedSet[T comparable](slice []T) *OrderedSet[T] {
+	set := &OrderedSet[T]{index: make(map[T]int, len(slice))}
+	set.Add(slice...)
+	return set
+}
+
+// OrderedSetFromSeq returns an ordered set that contains the distinct elements of the provided sequence in the order of their first occurrence.
+// The provided sequence must be finite.
+func OrderedSetFromSeq[T comparable](seq Seq[T]) *OrderedSet[T] {
+	set := &OrderedSet[T]{index: make(map[T]int)}
+	seq(func(v T) bool {
+		set.Add(v)
+		return true
+	})
+	return set
+}
+
+// Len returns the number of values in the set.
+func (s *OrderedSet[T]) Len() int {
+	return len(s.values)
+}
+
+// Add appends the provided values that are not contained yet to the set.
+func (s *OrderedSet[T]) Add(values ...T) {
+	if s.index == nil {
+		s.index = make(map[T]int, len(values))
+	}
+	for _, v := range values {
+		if _, contains := s.index[v]; !contains {
+			s.index[v] = len(s.values)
+			s.values = append(s.values, v)
+		}
+	}
+}
+
+// Remove removes the provided values from the set, values that are not contained are ignored.
+// The remaining values keep their order.
+func (s *OrderedSet[T]) Remove(values ...T) {
+	var removed []bool
+	for _, v := range values {
+		if i, contains := s.index[v]; contains {
+			if removed == nil {
+				removed = make([]bool, len(s.values))
+			}
+			removed[i] = true
+		}
+	}
+	if removed == nil {
+		return
+	}
+	// The remaining values are found by their position, NaN values can't be looked up by their value.
+	kept := s.values[:0]
+	for i, v := range s.values {
+		if !removed[i] {
+			kept = append(kept, v)
+		}
+	}
+	zeroTail(s.values, len(kept))
+	s.values = kept
+	s.index = make(map[T]int, len(kept))
+	for i, v := range kept {
+		s.index[v] = i
+	}
+}
+
+// Contains returns true when the set contains the provided value.
+func (s *OrderedSet[T]) Contains(value T) bool {
+	_, contains := s.index[value]
+	return contains
+}
+
+// ToSlice returns a slice that contains the values of the set in insertion order.
+func (s *OrderedSet[T]) ToSlice() []T {
+	result := make([]T, len(s.values))
+	copy(result, s.values)
+	return emptyResult(result)
+}
+
+// Seq returns a sequence that yields the values of the set in insertion order.
+// The set must not be modified during the iteration.
+func (s *OrderedSet[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Union returns an ordered set that contains the values of this set followed by the values of the other set that are not in this set.
+func (s *OrderedSet[T]) Union(other *OrderedSet[T]) *OrderedSet[T] {
+	result := &OrderedSet[T]{index: make(map[T]int, len(s.values)+len(other.values))}
+	result.Add(s.values...)
+	result.Add(other.values...)
+	return result
+}
+
+// Intersect returns an ordered set that contains the values of this set that are also in the other set, in the order of this set.
+func (s *OrderedSet[T]) Intersect(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.filter(other.Contains)
+}
+
+// Difference returns an ordered set that contains the values of this set that are not in the other set, in the order of this set.
+func (s *OrderedSet[T]) Difference(other *OrderedSet[T]) *OrderedSet[T] {
+	return s.filter(func(v T) bool { return !other.Contains(v) })
+}
+
+// SymmetricDifference returns an ordered set that contains the values of this set that are not in the other set,
+// followed by the values of the other set that are not in this set.
+func (s *OrderedSet[T]) SymmetricDifference(other *OrderedSet[T]) *OrderedSet[T] {
+	result := s.Difference(other)
+	for _, v := range other.values {
+		if !s.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// IsSubset returns true when every value of this set is also in the other set.
+func (s *OrderedSet[T]) IsSubset(other *OrderedSet[T]) bool {
+	if len(s.values) > len(other.values) {
+		return false
+	}
+	for _, v := range s.values {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true when every value of the other set is also in this set.
+func (s *OrderedSet[T]) IsSuperset(other *OrderedSet[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true when both sets contain the same values, regardless of their order.
+func (s *OrderedSet[T]) Equal(other *OrderedSet[T]) bool {
+	return len(s.values) == len(other.values) && s.IsSubset(other)
+}
+
+func (s *OrderedSet[T]) filter(condition func(T) bool) *OrderedSet[T] {
+	result := &OrderedSet[T]{index: make(map[T]int)}
+	for _, v := range s.values {
+		if condition(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package slinq
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	var set Set[int]
+	set.Add(1, 2, 2, 3)
+	set.Remove(2, 4)
+
+	if set.Len() != 2 || !set.Contains(1) || set.Contains(2) {
+		t.Errorf("Set = %v, want [1 3]", set.ToSlice())
+	}
+
+	got := set.ToSlice()
+	sort.Ints(got)
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	if got := new(Set[int]).ToSlice(); got != nil {
+		t.Errorf("ToSlice() = %#v, want nil", got)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := NewSet(1, 2, 3)
+	b := ToSet([]int{2, 3, 4})
+
+	tests := []struct {
+		name string
+		got  *Set[int]
+		want *Set[int]
+	}{
+		{name: "Union", got: a.Union(b), want: NewSet(1, 2, 3, 4)},
+		{name: "Intersect", got: a.Intersect(b), want: NewSet(2, 3)},
+		{name: "Difference", got: a.Difference(b), want: NewSet(1)},
+		{name: "SymmetricDifference", got: a.SymmetricDifference(b), want: NewSet(1, 4)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got.ToSlice(), tt.want.ToSlice())
+			}
+		})
+	}
+
+	if a.Len() != 3 || b.Len() != 3 {
+		t.Errorf("operations modified their operands")
+	}
+	if !NewSet(2, 3).IsSubset(a) || a.IsSubset(b) || !a.IsSuperset(NewSet(1)) || a.Equal(b) {
+		t.Errorf("IsSubset, IsSuperset or Equal returned a wrong result")
+	}
+}
+
+func TestSetFromSeq(t *testing.T) {
+	set := SetFromSeq(TakeSeq(Cycle([]string{"a", "b"}), 5))
+
+	if !set.Equal(NewSet("a", "b")) {
+		t.Errorf("SetFromSeq() = %v, want [a b]", set.ToSlice())
+	}
+	if got := Collect(set.Seq()); len(got) != 2 {
+		t.Errorf("Seq() = %v, want 2 values", got)
+	}
+}
+
+func TestOrderedSet(t *testing.T) {
+	var set OrderedSet[string]
+	set.Add("c", "a", "b", "a")
+	set.Remove("a", "x")
+	set.Add("d", "c")
+
+	if got, want := set.ToSlice(), []string{"c", "b", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSlice() = %v, want %v", got, want)
+	}
+	if got, want := Collect(set.Seq()), []string{"c", "b", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Seq() = %v, want %v", got, want)
+	}
+	if !set.Contains("b") || set.Contains("a") || set.Len() != 3 {
+		t.Errorf("Contains or Len returned a wrong result")
+	}
+}
+
+func TestOrderedSetOperations(t *testing.T) {
+	a := NewOrderedSet(3, 1, 2)
+	b := ToOrderedSet([]int{4, 2, 3})
+
+	tests := []struct {
+		name string
+		got  *OrderedSet[int]
+		want []int
+	}{
+		{name: "Union", got: a.Union(b), want: []int{3, 1, 2, 4}},
+		{name: "Intersect", got: a.Intersect(b), want: []int{3, 2}},
+		{name: "Difference", got: a.Difference(b), want: []int{1}},
+		{name: "SymmetricDifference", got: a.SymmetricDifference(b), want: []int{1, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.ToSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	if !NewOrderedSet(2, 3).IsSubset(a) || !a.IsSuperset(NewOrderedSet(1)) || !a.Equal(NewOrderedSet(1, 2, 3)) || a.Equal(b) {
+		t.Errorf("IsSubset, IsSuperset or Equal returned a wrong result")
+	}
+	if got, want := OrderedSetFromSeq(FromSlice([]int{5, 4, 5})).ToSlice(), []int{5, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OrderedSetFromSeq() = %v, want %v", got, want)
+	}
+}
+
+func TestSetNaN(t *testing.T) {
+	nan := math.NaN()
+
+	set := NewSet(nan, nan, 1.0)
+	if set.Len() != 3 || set.Contains(nan) {
+		t.Errorf("Set Len() = %v, Contains(NaN) = %v, want 3, false", set.Len(), set.Contains(nan))
+	}
+
+	ordered := NewOrderedSet(nan, 2.0, nan, 1.0)
+	ordered.Remove(1.0, nan)
+	if got, want := ordered.ToSlice(), []float64{nan, 2, nan}; !floatsEqual(got, want) {
+		t.Errorf("OrderedSet.Remove() = %v, want %v", got, want)
+	}
+	ordered.Remove(2.0)
+	if got, want := ordered.ToSlice(), []float64{nan, nan}; !floatsEqual(got, want) || len(ordered.index) != 2 {
+		t.Errorf("OrderedSet.Remove() = %v with %v index entries, want %v with 2", got, len(ordered.index), want)
+	}
+}
@@ -1,11 +1,13 @@
 // Package slinq is a collection of LINQ functions that is admittedly not nearly as versatile as its original.
 // It is mostly only for slices (-> s(lices)linq) and unfortunately not chainable because generics usage on methods is quite restricted in go.
+//
+// Operators that return a slice return nil when the result has no elements, also when they return an error.
+// Set NonNilEmpty to get non-nil empty slices instead, or wrap a single result with NonNil.
+// The exceptions are the ...Into functions, which return the provided dst like append does, and the ...InPlace functions, which return the shortened input.
+// Operators that return a map always return a non-nil map.
 package slinq
 
-import (
-	"errors"
-	"math"
-)
+import "errors"
 
 // Aggregate applies an accumulator function to every element of the provided slice.
 // The provided value is used as the initial value for the accumulator and the provided function is used to select the result value.
@@ -18,11 +20,8 @@ func Aggregate[T any](slice []T, initial T, accumulator func(T, T) T) T {
 }
 
 // All returns true when all the elements in the provided slice satisfy the provided condition.
+// Like in LINQ, All returns true for an empty slice, use AllNonEmpty to require at least one element.
 func All[T any](slice []T, condition func(T) bool) bool {
-	if len(slice) == 0 {
-		return false
-	}
-
 	for _, v := range slice {
 		if !condition(v) {
 			return false
@@ -31,6 +30,21 @@ func All[T any](slice []T, condition func(T) bool) bool {
 	return true
 }
 
+// AllNonEmpty returns true when the provided slice is not empty and all of its elements satisfy the provided condition.
+// This is how All behaved before it followed LINQ for empty slices.
+func AllNonEmpty[T any](slice []T, condition func(T) bool) bool {
+	return len(slice) > 0 && All(slice, condition)
+}
+
+// Append returns a slice with the elements of the provided slice followed by the provided element.
+// The provided slice is never modified.
+func Append[T any](slice []T, element T) []T {
+	result := make([]T, len(slice)+1)
+	copy(result, slice)
+	result[len(slice)] = element
+	return result
+}
+
 // Any returns true when at least one element in the provided slice satisfies the provided condition.
 func Any[T any](slice []T, condition func(T) bool) bool {
 	if len(slice) == 0 {
@@ -46,19 +60,34 @@ func Any[T any](slice []T, condition func(T) bool) bool {
 }
 
 // Chunk returns a slice of slices of the provided size that contain the elements of the provided slice.
+// All chunks share one newly allocated backing array, so Chunk allocates exactly twice.
 func Chunk[T any](slice []T, size int) ([][]T, error) {
-	cap := math.Ceil(float64(len(slice)) / float64(size))
-	result := make([][]T, int(cap))
-	if size == 0 {
-		return result, errors.New("size cannot be zero")
+	if size <= 0 {
+		return emptyResult[[]T](nil), errors.New("size must be greater than zero")
 	}
 
-	for i, v := range slice {
-		chunkIndex := i / size
-		result[chunkIndex] = append(result[chunkIndex], v)
+	count := len(slice) / size
+	if len(slice)%size != 0 {
+		count++
+	}
+	result := make([][]T, count)
+	backing := make([]T, len(slice))
+	copy(backing, slice)
+	for i := range result {
+		low := i * size
+		high := len(backing)
+		if high-low > size {
+			high = low + size
+		}
+		result[i] = backing[low:high:high]
 	}
 
-	return result, nil
+	return emptyResult(result), nil
+}
+
+// Concat returns a slice that contains the elements of all the provided slices in order.
+func Concat[T any](slices ...[]T) []T {
+	return Flatten(slices)
 }
 
 // Count returns the count of elements in the provided slice that satisfy the provided condition.
@@ -73,19 +102,21 @@ func Count[T any](slice []T, condition func(T) bool) int {
 }
 
 // Distinct returns removes duplicate values from the provided slice. The order of the elements is not maintained/given.
+// NaN is never equal to itself, so every NaN is kept, use DistinctFloat for floats that can be NaN.
 func Distinct[T comparable](slice []T) []T {
-	var result []T
 	dict := make(map[T]int, len(slice))
 	for i, v := range slice {
 		dict[v] = i
 	}
-	for k, _ := range dict {
+	result := make([]T, 0, len(dict))
+	for k := range dict {
 		result = append(result, k)
 	}
-	return result
+	return emptyResult(result)
 }
 
 // Except returns the elements of the first provided slice that don't appear in the second provided slice.
+// Unlike in LINQ, duplicates in the first slice are kept.
 func Except[T comparable](first, second []T) []T {
 	var result []T
 	dict := make(map[T]int, len(second))
@@ -97,10 +128,32 @@ func Except[T comparable](first, second []T) []T {
 			result = append(result, v)
 		}
 	}
-	return result
+	return emptyResult(result)
+}
+
+// Interleave returns a slice that takes one element of each provided slice in turn until all of them are exhausted.
+// Slices that run out of elements are skipped.
+func Interleave[T any](slices ...[]T) []T {
+	length, longest := 0, 0
+	for _, inner := range slices {
+		length += len(inner)
+		if len(inner) > longest {
+			longest = len(inner)
+		}
+	}
+	result := make([]T, 0, length)
+	for i := 0; i < longest; i++ {
+		for _, inner := range slices {
+			if i < len(inner) {
+				result = append(result, inner[i])
+			}
+		}
+	}
+	return emptyResult(result)
 }
 
 // Intersect returns the elements that appear in both of the provided slices.
+// Unlike in LINQ, the elements are taken from the second slice and duplicates are kept.
 func Intersect[T comparable](first, second []T) []T {
 	var result []T
 	dict := make(map[T]int, len(first))
@@ -112,10 +165,11 @@ func Intersect[T comparable](first, second []T) []T {
 			result = append(result, v)
 		}
 	}
-	return result
+	return emptyResult(result)
 }
 
-// First returns a pointer to the first element of the provided slice that satisfies the provided condition
+// First returns the first element of the provided slice that satisfies the provided condition.
+// Returns the zero value and an error if the slice is empty or no element satisfies the condition.
 func First[T any](slice []T, condition func(T) bool) (T, error) {
 	if len(slice) == 0 {
 		var zero T
@@ -127,46 +181,105 @@ func First[T any](slice []T, condition func(T) bool) (T, error) {
 			return v, nil
 		}
 	}
-	return slice[0], errors.New("no element in the slice satisfies the condition")
+	var zero T
+	return zero, errors.New("no element in the slice satisfies the condition")
+}
+
+// Flatten returns a slice that contains the elements of all the inner slices of the provided slice in order.
+func Flatten[T any](slices [][]T) []T {
+	length := 0
+	for _, inner := range slices {
+		length += len(inner)
+	}
+	result := make([]T, 0, length)
+	for _, inner := range slices {
+		result = append(result, inner...)
+	}
+	return emptyResult(result)
+}
+
+// FlattenDeep returns a slice that contains the elements of all the innermost slices of the provided slice in order.
+func FlattenDeep[T any](slices [][][]T) []T {
+	length := 0
+	for _, middle := range slices {
+		for _, inner := range middle {
+			length += len(inner)
+		}
+	}
+	result := make([]T, 0, length)
+	for _, middle := range slices {
+		for _, inner := range middle {
+			result = append(result, inner...)
+		}
+	}
+	return emptyResult(result)
+}
+
+// Prepend returns a slice with the provided element followed by the elements of the provided slice.
+// The provided slice is never modified.
+func Prepend[T any](slice []T, element T) []T {
+	result := make([]T, len(slice)+1)
+	result[0] = element
+	copy(result[1:], slice)
+	return result
 }
 
 // Repeat generates a slice that contains one repeated value the provided number of times.
+// A negative count produces an empty slice.
 func Repeat[T any](value T, count int) []T {
-	var result []T
+	if count < 0 {
+		count = 0
+	}
+	return emptyResult(RepeatInto(make([]T, 0, count), value, count))
+}
+
+// RepeatInto appends the provided value the provided number of times to dst and returns the extended slice.
+func RepeatInto[T any](dst []T, value T, count int) []T {
+	if count <= 0 {
+		return dst
+	}
+	dst = grow(dst, count)
 	for i := 0; i < count; i++ {
-		result = append(result, value)
+		dst = append(dst, value)
 	}
-	return result
+	return dst
 }
 
 // Reverse returns a slice with the elements of the provided slice in reversed order.
 func Reverse[T any](slice []T) []T {
-	var result []T
+	return emptyResult(ReverseInto(make([]T, 0, len(slice)), slice))
+}
+
+// ReverseInto appends the elements of the provided slice in reversed order to dst and returns the extended slice.
+func ReverseInto[T any](dst []T, slice []T) []T {
+	dst = grow(dst, len(slice))
 	for i := len(slice) - 1; i >= 0; i-- {
-		result = append(result, slice[i])
+		dst = append(dst, slice[i])
 	}
-	return result
+	return dst
 }
 
 // Select returns a slice of elements of the provided slice that have been modified by the provided selector.
 func Select[TSource any, TResult any](slice []TSource, selector func(TSource) TResult) []TResult {
-	var result []TResult
+	return emptyResult(SelectInto(make([]TResult, 0, len(slice)), slice, selector))
+}
+
+// SelectInto appends the elements of the provided slice that have been modified by the provided selector to dst and returns the extended slice.
+func SelectInto[TSource any, TResult any](dst []TResult, slice []TSource, selector func(TSource) TResult) []TResult {
+	dst = grow(dst, len(slice))
 	for _, v := range slice {
-		result = append(result, selector(v))
+		dst = append(dst, selector(v))
 	}
-
-	return result
+	return dst
 }
 
 // SelectMany returns a slice of elements of the provided slice that have been modified by the provided selector and flattened into a single slice.
 func SelectMany[TSource any, TResult any](slice []TSource, selector func(TSource, int) []TResult) []TResult {
 	var result []TResult
 	for i, outer := range slice {
-		for _, inner := range selector(outer, i) {
-			result = append(result, inner)
-		}
+		result = append(result, selector(outer, i)...)
 	}
-	return result
+	return emptyResult(result)
 }
 
 // Single returns a single, specific element of the provided slice, returns an error if there are not exactly one element that satisfy the provided condition.
@@ -190,10 +303,20 @@ func Single[T any](slice []T, condition func(T) bool) (T, error) {
 		}
 	}
 
+	if !found {
+		return result, errors.New("no element in the slice satisfies the condition")
+	}
 	return result, nil
 }
 
+// TakeWhile returns the elements of the provided slice up to, but not including, the first element that doesn't satisfy the provided condition.
+func TakeWhile[T any](slice []T, condition func(T) bool) []T {
+	return TakeWhileIndexed(slice, func(v T, _ int) bool { return condition(v) })
+}
+
 // ToMap returns a map that was created by applying the provided key- and value-selector to the elements of the provided slice.
+// Elements with a key that occurred before overwrite the earlier value, use ToMapWith to handle duplicate keys differently.
+// Use ToOrderedMap to keep the keys in the order of the elements.
 func ToMap[T any, TKey comparable, TValue any](slice []T, keySelector func(T) TKey, valueSelector func(T) TValue) map[TKey]TValue {
 	dict := make(map[TKey]TValue, len(slice))
 
@@ -205,30 +328,49 @@ func ToMap[T any, TKey comparable, TValue any](slice []T, keySelector func(T) TK
 }
 
 // ToSlice returns a slice that was created by applying the provided selector to the key-value pairs of the provided map.
+// The order follows the random iteration order of the map, use ToSliceOrdered or ToSliceSorted for a deterministic order.
 func ToSlice[TKey comparable, TValue any, TResult any](dict map[TKey]TValue, selector func(TKey, TValue) TResult) []TResult {
-	var result []TResult
+	return emptyResult(ToSliceInto(make([]TResult, 0, len(dict)), dict, selector))
+}
+
+// ToSliceInto appends the results of applying the provided selector to the key-value pairs of the provided map to dst and returns the extended slice.
+func ToSliceInto[TKey comparable, TValue any, TResult any](dst []TResult, dict map[TKey]TValue, selector func(TKey, TValue) TResult) []TResult {
+	dst = grow(dst, len(dict))
 	for key, value := range dict {
-		result = append(result, selector(key, value))
+		dst = append(dst, selector(key, value))
 	}
-	return result
+	return dst
 }
 
 // Where returns a slice that contains all the elements of the provided slice that satisfy the provided condition.
+// The length of the result is not known in advance, so it grows like append does.
 func Where[T any](slice []T, condition func(T) bool) []T {
-	var result []T
+	return emptyResult(WhereInto(nil, slice, condition))
+}
+
+// WhereInto appends the elements of the provided slice that satisfy the provided condition to dst and returns the extended slice.
+// Reusing dst across calls avoids allocating once its capacity suffices.
+func WhereInto[T any](dst []T, slice []T, condition func(T) bool) []T {
 	for _, v := range slice {
 		if condition(v) {
-			result = append(result, v)
+			dst = append(dst, v)
 		}
 	}
-	return result
+	return dst
 }
 
 // Zip returns a slice that was created by applying the provided selector to each corresponding elements of both provided slices.
 // Elements that don't have a corresponding element at their index are ignored.
 func Zip[T1 any, T2 any, TResult any](first []T1, second []T2, selector func(T1, T2) TResult) []TResult {
-	var result []TResult
+	length := len(first)
+	if len(second) < length {
+		length = len(second)
+	}
+	return emptyResult(ZipInto(make([]TResult, 0, length), first, second, selector))
+}
 
+// ZipInto appends the results of applying the provided selector to each corresponding elements of both provided slices to dst and returns the extended slice.
+func ZipInto[T1 any, T2 any, TResult any](dst []TResult, first []T1, second []T2, selector func(T1, T2) TResult) []TResult {
 	var shorterLength int
 	if len(first) < len(second) {
 		shorterLength = len(first)
@@ -236,11 +378,22 @@ func Zip[T1 any, T2 any, TResult any](first []T1, second []T2, selector func(T1,
 		shorterLength = len(second)
 	}
 
+	dst = grow(dst, shorterLength)
 	for i := 0; i < shorterLength; i++ {
 		elementFirst := first[i]
 		elementSecond := second[i]
-		result = append(result, selector(elementFirst, elementSecond))
+		dst = append(dst, selector(elementFirst, elementSecond))
 	}
 
+	return dst
+}
+
+// grow returns the provided slice with enough capacity to append n more elements without another allocation.
+func grow[T any](slice []T, n int) []T {
+	if cap(slice)-len(slice) >= n {
+		return slice
+	}
+	result := make([]T, len(slice), len(slice)+n)
+	copy(result, slice)
 	return result
 }
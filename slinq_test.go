@@ -2,7 +2,9 @@ package slinq
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -76,6 +78,11 @@ func TestAll(t *testing.T) {
 			args: args{[]string{"yes", "no", "maybe"}, stringContainsLetterO},
 			want: false,
 		},
+		{
+			name: "Should return true for empty slice",
+			args: args{[]string{}, stringContainsLetterO},
+			want: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,6 +173,15 @@ func TestChunk(t *testing.T) {
 			want:    [][]int{{1, 2, 3, 4, 5, 6}, {7, 8, 9, 10, 11, 12}, {13, 14, 15, 16, 17, 18}, {19}},
 			wantErr: false,
 		},
+		{
+			name: "Should return a single chunk for the largest size",
+			args: args{
+				slice: []int{1, 2},
+				size:  math.MaxInt,
+			},
+			want:    [][]int{{1, 2}},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -342,12 +358,12 @@ func TestFirst(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "Should return empty slice.",
+			name: "Should return zero value and error because no number is divisible by 3.",
 			args: args{
 				slice:     []int{4, 5, 1, 7, 8},
 				condition: divisibleByThree,
 			},
-			want:    4,
+			want:    0,
 			wantErr: true,
 		},
 	}
@@ -688,7 +704,10 @@ func TestToSlice(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := ToSlice(tt.args.dict, tt.args.selector); !reflect.DeepEqual(got, tt.want) {
+			got := ToSlice(tt.args.dict, tt.args.selector)
+			// map iteration order is undefined, so the result is sorted before comparing
+			sort.Slice(got, func(i, j int) bool { return got[i].id < got[j].id })
+			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("ToSlice() = %v, want %v", got, tt.want)
 			}
 		})
@@ -766,3 +785,127 @@ func TestZip(t *testing.T) {
 		})
 	}
 }
+
+func TestAppend(t *testing.T) {
+	slice := []int{1, 2, 3}
+	want := []int{1, 2, 3, 4}
+	if got := Append(slice, 4); !reflect.DeepEqual(got, want) || cap(got) != len(want) {
+		t.Errorf("Append() = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(slice, []int{1, 2, 3}) {
+		t.Errorf("Append() modified the provided slice: %v", slice)
+	}
+}
+
+func TestPrepend(t *testing.T) {
+	slice := []int{1, 2, 3}
+	want := []int{0, 1, 2, 3}
+	if got := Prepend(slice, 0); !reflect.DeepEqual(got, want) || cap(got) != len(want) {
+		t.Errorf("Prepend() = %v, want %v", got, want)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices [][]int
+		want   []int
+	}{
+		{
+			name:   "Should join the slices in order",
+			slices: [][]int{{1, 2}, {}, {3}, {4, 5, 6}},
+			want:   []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:   "Should return nil",
+			slices: nil,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Concat(tt.slices...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Concat() = %v, want %v", got, tt.want)
+			}
+			if cap(got) != len(tt.want) {
+				t.Errorf("Concat() cap = %v, want %v", cap(got), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices [][]string
+		want   []string
+	}{
+		{
+			name:   "Should take elements round-robin",
+			slices: [][]string{{"a1", "a2", "a3"}, {"b1"}, {"c1", "c2"}},
+			want:   []string{"a1", "b1", "c1", "a2", "c2", "a3"},
+		},
+		{
+			name:   "Should return nil",
+			slices: [][]string{{}, {}},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Interleave(tt.slices...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Interleave() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	want := []int{1, 2, 3, 4}
+	if got := Flatten([][]int{{1}, {2, 3}, nil, {4}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %v, want %v", got, want)
+	}
+	if got := FlattenDeep([][][]int{{{1}, {2}}, {}, {{3, 4}}}); !reflect.DeepEqual(got, want) || cap(got) != len(want) {
+		t.Errorf("FlattenDeep() = %v, want %v", got, want)
+	}
+}
+
+func TestInto(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	isOdd := func(i int) bool { return i%2 == 1 }
+	add := func(a, b int) int { return a + b }
+	prefix := []int{-1}
+
+	tests := []struct {
+		name string
+		got  []int
+		want []int
+	}{
+		{name: "SelectInto", got: SelectInto(prefix, []int{1, 2}, double), want: []int{-1, 2, 4}},
+		{name: "WhereInto", got: WhereInto(prefix, []int{1, 2, 3}, isOdd), want: []int{-1, 1, 3}},
+		{name: "ReverseInto", got: ReverseInto(prefix, []int{1, 2}), want: []int{-1, 2, 1}},
+		{name: "RepeatInto", got: RepeatInto(prefix, 0, 2), want: []int{-1, 0, 0}},
+		{name: "ZipInto", got: ZipInto(prefix, []int{1, 2, 3}, []int{10, 20}, add), want: []int{-1, 11, 22}},
+		{name: "ToSliceInto", got: ToSliceInto(prefix, map[int]int{1: 2}, add), want: []int{-1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+	if !reflect.DeepEqual(prefix, []int{-1}) {
+		t.Errorf("Into functions modified the provided dst: %v", prefix)
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	isPositive := func(i int) bool { return i > 0 }
+
+	want := []int{3, 2}
+	if got := TakeWhile([]int{3, 2, 0, 5}, isPositive); !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeWhile() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,198 @@
+package slinq
+
+// IsSorted returns true when the elements of the provided slice are in ascending order.
+func IsSorted[T Ordered](slice []T) bool {
+	return isSorted(slice, compareOrdered[T])
+}
+
+// IsSortedWith returns true when the elements of the provided slice are in ascending order according to the provided comparer.
+func IsSortedWith[T any](slice []T, comparer Comparer[T]) bool {
+	return isSorted(slice, comparer.Compare)
+}
+
+// IsSortedBy returns true when the keys the provided selector returns for the elements of the provided slice are in ascending order.
+func IsSortedBy[T any, TKey Ordered](slice []T, keySelector func(T) TKey) bool {
+	for i := 1; i < len(slice); i++ {
+		if keySelector(slice[i]) < keySelector(slice[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// LowerBound returns the index of the first element of the provided slice whose key is not less than the provided key.
+// Returns the length of the slice if there is no such element. The slice must be sorted by the keys the provided selector returns.
+func LowerBound[T any, TKey Ordered](slice []T, key TKey, keySelector func(T) TKey) int {
+	low, high := 0, len(slice)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if keySelector(slice[mid]) < key {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// UpperBound returns the index of the first element of the provided slice whose key is greater than the provided key.
+// Returns the length of the slice if there is no such element. The slice must be sorted by the keys the provided selector returns.
+func UpperBound[T any, TKey Ordered](slice []T, key TKey, keySelector func(T) TKey) int {
+	low, high := 0, len(slice)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if key < keySelector(slice[mid]) {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low
+}
+
+// BinarySearch returns the index of the first element of the provided slice with the provided key and whether such an element exists.
+// If it doesn't exist, the returned index is where it would have to be inserted. The slice must be sorted by the keys the provided selector returns.
+func BinarySearch[T any, TKey Ordered](slice []T, key TKey, keySelector func(T) TKey) (int, bool) {
+	i := LowerBound(slice, key, keySelector)
+	return i, i < len(slice) && keySelector(slice[i]) == key
+}
+
+// SortedDistinct returns the elements of the provided sorted slice with duplicates removed, the result is sorted as well.
+func SortedDistinct[T Ordered](slice []T) []T {
+	return sortedDistinct("SortedDistinct", slice, compareOrdered[T])
+}
+
+// SortedDistinctWith is SortedDistinct for slices that are sorted according to the provided comparer.
+func SortedDistinctWith[T any](slice []T, comparer Comparer[T]) []T {
+	return sortedDistinct("SortedDistinctWith", slice, comparer.Compare)
+}
+
+// SortedExcept returns the elements of the first provided slice that don't appear in the second provided slice.
+// Both slices must be sorted, the result is sorted and contains every element once.
+// The slices are merged in linear time without building a map.
+func SortedExcept[T Ordered](first, second []T) []T {
+	return sortedExcept("SortedExcept", first, second, compareOrdered[T])
+}
+
+// SortedExceptWith is SortedExcept for slices that are sorted according to the provided comparer.
+func SortedExceptWith[T any](first, second []T, comparer Comparer[T]) []T {
+	return sortedExcept("SortedExceptWith", first, second, comparer.Compare)
+}
+
+// SortedIntersect returns the elements that appear in both of the provided slices.
+// Both slices must be sorted, the result is sorted and contains every element once.
+// The slices are merged in linear time without building a map.
+func SortedIntersect[T Ordered](first, second []T) []T {
+	return sortedIntersect("SortedIntersect", first, second, compareOrdered[T])
+}
+
+// SortedIntersectWith is SortedIntersect for slices that are sorted according to the provided comparer.
+func SortedIntersectWith[T any](first, second []T, comparer Comparer[T]) []T {
+	return sortedIntersect("SortedIntersectWith", first, second, comparer.Compare)
+}
+
+// SortedUnion returns the elements that appear in any of the provided slices.
+// Both slices must be sorted, the result is sorted and contains every element once.
+// The slices are merged in linear time without building a map.
+func SortedUnion[T Ordered](first, second []T) []T {
+	return sortedUnion("SortedUnion", first, second, compareOrdered[T])
+}
+
+// SortedUnionWith is SortedUnion for slices that are sorted according to the provided comparer.
+func SortedUnionWith[T any](first, second []T, comparer Comparer[T]) []T {
+	return sortedUnion("SortedUnionWith", first, second, comparer.Compare)
+}
+
+func compareOrdered[T Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case b < a:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func sortedDistinct[T any](function string, slice []T, compare func(T, T) int) []T {
+	checkSorted(function, compare, slice)
+	var result []T
+	for i, v := range slice {
+		if i == 0 || compare(v, slice[i-1]) != 0 {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+func sortedExcept[T any](function string, first, second []T, compare func(T, T) int) []T {
+	checkSorted(function, compare, first, second)
+	var result []T
+	j := 0
+	for _, v := range first {
+		for j < len(second) && compare(second[j], v) < 0 {
+			j++
+		}
+		if (j == len(second) || compare(second[j], v) != 0) && (len(result) == 0 || compare(result[len(result)-1], v) != 0) {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+func sortedIntersect[T any](function string, first, second []T, compare func(T, T) int) []T {
+	checkSorted(function, compare, first, second)
+	var result []T
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		switch c := compare(first[i], second[j]); {
+		case c < 0:
+			i++
+		case c > 0:
+			j++
+		default:
+			if len(result) == 0 || compare(result[len(result)-1], first[i]) != 0 {
+				result = append(result, first[i])
+			}
+			i++
+			j++
+		}
+	}
+	return emptyResult(result)
+}
+
+func sortedUnion[T any](function string, first, second []T, compare func(T, T) int) []T {
+	checkSorted(function, compare, first, second)
+	var result []T
+	add := func(v T) {
+		if len(result) == 0 || compare(result[len(result)-1], v) != 0 {
+			result = append(result, v)
+		}
+	}
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		if compare(second[j], first[i]) < 0 {
+			add(second[j])
+			j++
+		} else {
+			add(first[i])
+			i++
+		}
+	}
+	for ; i < len(first); i++ {
+		add(first[i])
+	}
+	for ; j < len(second); j++ {
+		add(second[j])
+	}
+	return emptyResult(result)
+}
+
+func isSorted[T any](slice []T, compare func(T, T) int) bool {
+	for i := 1; i < len(slice); i++ {
+		if compare(slice[i], slice[i-1]) < 0 {
+			return false
+		}
+	}
+	return true
+}
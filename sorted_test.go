@@ -0,0 +1,95 @@
+package slinq
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		want  bool
+	}{
+		{name: "Should return true for empty slice", slice: []int{}, want: true},
+		{name: "Should return true for ascending slice with duplicates", slice: []int{1, 2, 2, 3}, want: true},
+		{name: "Should return false", slice: []int{1, 3, 2}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSorted(tt.slice); got != tt.want {
+				t.Errorf("IsSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	length := func(s string) int { return len(s) }
+	if !IsSortedBy([]string{"a", "bb", "cc", "ddd"}, length) || IsSortedBy([]string{"aa", "b"}, length) {
+		t.Errorf("IsSortedBy() did not compare by key")
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	age := func(u user) int { return u.age }
+	users := []user{{"a", 20}, {"b", 30}, {"c", 30}, {"d", 40}}
+
+	tests := []struct {
+		name      string
+		key       int
+		wantIndex int
+		wantFound bool
+		wantLower int
+		wantUpper int
+	}{
+		{name: "Should find first of equal keys", key: 30, wantIndex: 1, wantFound: true, wantLower: 1, wantUpper: 3},
+		{name: "Should return insertion index", key: 35, wantIndex: 3, wantFound: false, wantLower: 3, wantUpper: 3},
+		{name: "Should return length for key after all", key: 50, wantIndex: 4, wantFound: false, wantLower: 4, wantUpper: 4},
+		{name: "Should return zero for key before all", key: 10, wantIndex: 0, wantFound: false, wantLower: 0, wantUpper: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, found := BinarySearch(users, tt.key, age); got != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("BinarySearch() = %v, %v, want %v, %v", got, found, tt.wantIndex, tt.wantFound)
+			}
+			if got := LowerBound(users, tt.key, age); got != tt.wantLower {
+				t.Errorf("LowerBound() = %v, want %v", got, tt.wantLower)
+			}
+			if got := UpperBound(users, tt.key, age); got != tt.wantUpper {
+				t.Errorf("UpperBound() = %v, want %v", got, tt.wantUpper)
+			}
+		})
+	}
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	first := []int{1, 2, 2, 3, 5, 8}
+	second := []int{2, 3, 3, 4, 8, 9}
+
+	if got, want := SortedIntersect(first, second), []int{2, 3, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedIntersect() = %v, want %v", got, want)
+	}
+	if got, want := SortedExcept(first, second), []int{1, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedExcept() = %v, want %v", got, want)
+	}
+	if got, want := SortedUnion(first, second), []int{1, 2, 3, 4, 5, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedUnion() = %v, want %v", got, want)
+	}
+	if got, want := SortedDistinct(first), []int{1, 2, 3, 5, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedDistinct() = %v, want %v", got, want)
+	}
+}
+
+func TestSortedDebugCheck(t *testing.T) {
+	Debug = true
+	defer func() {
+		Debug = false
+		if recover() == nil {
+			t.Errorf("SortedIntersect() did not panic for unsorted input in debug mode")
+		}
+	}()
+	SortedIntersect([]int{3, 1}, []int{1})
+}
@@ -0,0 +1,87 @@
+package slinq
+
+import "fmt"
+
+// DuplicateKeyResolver decides the value of a key that ToMapWith finds more than once.
+// It receives the key, the value that is already in the map and the value of the duplicate, and returns the value to keep or an error to abort.
+type DuplicateKeyResolver[TKey comparable, TValue any] func(key TKey, existing, duplicate TValue) (TValue, error)
+
+// DuplicateKeyError is returned by ToMapWith with ErrorOnDuplicate when a key is found more than once.
+type DuplicateKeyError[TKey comparable] struct {
+	Key TKey
+}
+
+func (e *DuplicateKeyError[TKey]) Error() string {
+	return fmt.Sprintf("duplicate key: %v", e.Key)
+}
+
+// LastWins keeps the value of the last element with a key, which is what ToMap does.
+func LastWins[TKey comparable, TValue any]() DuplicateKeyResolver[TKey, TValue] {
+	return func(_ TKey, _, duplicate TValue) (TValue, error) {
+		return duplicate, nil
+	}
+}
+
+// FirstWins keeps the value of the first element with a key.
+func FirstWins[TKey comparable, TValue any]() DuplicateKeyResolver[TKey, TValue] {
+	return func(_ TKey, existing, _ TValue) (TValue, error) {
+		return existing, nil
+	}
+}
+
+// ErrorOnDuplicate aborts with a *DuplicateKeyError that contains the key that was found more than once.
+func ErrorOnDuplicate[TKey comparable, TValue any]() DuplicateKeyResolver[TKey, TValue] {
+	return func(key TKey, existing, _ TValue) (TValue, error) {
+		return existing, &DuplicateKeyError[TKey]{key}
+	}
+}
+
+// MergeWith combines the values of elements with the same key with the provided function, in the order of the elements.
+func MergeWith[TKey comparable, TValue any](merge func(existing, duplicate TValue) TValue) DuplicateKeyResolver[TKey, TValue] {
+	return func(_ TKey, existing, duplicate TValue) (TValue, error) {
+		return merge(existing, duplicate), nil
+	}
+}
+
+// ToMapWith returns a map that was created by applying the provided key- and value-selector to the elements of the provided slice.
+// The provided resolver decides the value of keys that occur more than once, if it returns an error, ToMapWith stops and returns the error together with the entries up to the duplicate.
+func ToMapWith[T any, TKey comparable, TValue any](slice []T, keySelector func(T) TKey, valueSelector func(T) TValue, resolver DuplicateKeyResolver[TKey, TValue]) (map[TKey]TValue, error) {
+	dict := make(map[TKey]TValue, len(slice))
+
+	for _, v := range slice {
+		key, value := keySelector(v), valueSelector(v)
+		if existing, exists := dict[key]; exists {
+			resolved, err := resolver(key, existing, value)
+			if err != nil {
+				return dict, err
+			}
+			value = resolved
+		}
+		dict[key] = value
+	}
+
+	return dict, nil
+}
+
+// ToMapMulti returns a map of the keys the provided key-selector returns to the values of all the elements with that key, in their original order.
+func ToMapMulti[T any, TKey comparable, TValue any](slice []T, keySelector func(T) TKey, valueSelector func(T) TValue) map[TKey][]TValue {
+	dict := make(map[TKey][]TValue)
+
+	for _, v := range slice {
+		key := keySelector(v)
+		dict[key] = append(dict[key], valueSelector(v))
+	}
+
+	return dict
+}
+
+// ToMapIndexed is ToMap with selectors that also receive the index of the element.
+func ToMapIndexed[T any, TKey comparable, TValue any](slice []T, keySelector func(T, int) TKey, valueSelector func(T, int) TValue) map[TKey]TValue {
+	dict := make(map[TKey]TValue, len(slice))
+
+	for i, v := range slice {
+		dict[keySelector(v, i)] = valueSelector(v, i)
+	}
+
+	return dict
+}
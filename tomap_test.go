@@ -0,0 +1,80 @@
+package slinq
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestToMapWith(t *testing.T) {
+	type entry struct {
+		key   string
+		value int
+	}
+	key := func(e entry) string { return e.key }
+	value := func(e entry) int { return e.value }
+	slice := []entry{{"a", 1}, {"b", 2}, {"a", 3}, {"a", 4}}
+
+	tests := []struct {
+		name     string
+		resolver DuplicateKeyResolver[string, int]
+		want     map[string]int
+		wantKey  string
+	}{
+		{
+			name:     "Should keep the last value",
+			resolver: LastWins[string, int](),
+			want:     map[string]int{"a": 4, "b": 2},
+		},
+		{
+			name:     "Should keep the first value",
+			resolver: FirstWins[string, int](),
+			want:     map[string]int{"a": 1, "b": 2},
+		},
+		{
+			name:     "Should merge the values",
+			resolver: MergeWith[string](func(existing, duplicate int) int { return existing + duplicate }),
+			want:     map[string]int{"a": 8, "b": 2},
+		},
+		{
+			name:     "Should return error with the duplicate key",
+			resolver: ErrorOnDuplicate[string, int](),
+			want:     map[string]int{"a": 1, "b": 2},
+			wantKey:  "a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToMapWith(slice, key, value, tt.resolver)
+			var duplicate *DuplicateKeyError[string]
+			if errors.As(err, &duplicate) != (tt.wantKey != "") || (duplicate != nil && duplicate.Key != tt.wantKey) {
+				t.Errorf("ToMapWith() error = %v, want duplicate key %q", err, tt.wantKey)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ToMapWith() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMapMulti(t *testing.T) {
+	type visit struct {
+		user string
+		page string
+	}
+	visits := []visit{{"ann", "/"}, {"bob", "/a"}, {"ann", "/b"}}
+
+	want := map[string][]string{"ann": {"/", "/b"}, "bob": {"/a"}}
+	got := ToMapMulti(visits, func(v visit) string { return v.user }, func(v visit) string { return v.page })
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMapMulti() = %v, want %v", got, want)
+	}
+}
+
+func TestToMapIndexed(t *testing.T) {
+	want := map[string]int{"a": 0, "b": 1}
+	got := ToMapIndexed([]string{"a", "b"}, func(s string, _ int) string { return s }, func(_ string, i int) int { return i })
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMapIndexed() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+package slinq
+
+// TopK returns the k elements of the provided slice with the largest keys, ordered from the largest to the smallest key.
+// Elements with equal keys keep their original order. NaN keys come after all other keys, so they are only returned when there are fewer than k other elements.
+// Runs in O(n log k) time without sorting the whole slice.
+func TopK[T any, TKey Ordered](slice []T, k int, keySelector func(T) TKey) []T {
+	return selectK(FromSlice(slice), k, len(slice), keySelector, greaterOrdered[TKey])
+}
+
+// TopKSeq is the sequence variant of TopK. Only k elements are kept in memory while the sequence is iterated.
+func TopKSeq[T any, TKey Ordered](seq Seq[T], k int, keySelector func(T) TKey) []T {
+	return selectK(seq, k, 0, keySelector, greaterOrdered[TKey])
+}
+
+// BottomK returns the k elements of the provided slice with the smallest keys, ordered from the smallest to the largest key.
+// Elements with equal keys keep their original order. NaN keys come after all other keys, like in TopK.
+// Runs in O(n log k) time without sorting the whole slice.
+func BottomK[T any, TKey Ordered](slice []T, k int, keySelector func(T) TKey) []T {
+	return selectK(FromSlice(slice), k, len(slice), keySelector, lessOrderedNaNLast[TKey])
+}
+
+// BottomKSeq is the sequence variant of BottomK. Only k elements are kept in memory while the sequence is iterated.
+func BottomKSeq[T any, TKey Ordered](seq Seq[T], k int, keySelector func(T) TKey) []T {
+	return selectK(seq, k, 0, keySelector, lessOrderedNaNLast[TKey])
+}
+
+// TopKWith returns the k elements of the provided slice that come last according to the provided comparer, ordered from the last to the first.
+// Elements that the comparer considers equal keep their original order.
+func TopKWith[T any](slice []T, k int, comparer Comparer[T]) []T {
+	return selectK(FromSlice(slice), k, len(slice), identity[T], func(a, b T) bool { return comparer.Compare(a, b) > 0 })
+}
+
+// BottomKWith returns the k elements of the provided slice that come first according to the provided comparer, in order.
+// Elements that the comparer considers equal keep their original order.
+func BottomKWith[T any](slice []T, k int, comparer Comparer[T]) []T {
+	return selectK(FromSlice(slice), k, len(slice), identity[T], func(a, b T) bool { return comparer.Compare(a, b) < 0 })
+}
+
+// greaterOrdered returns true when a ranks before b in TopK: greater values first and NaN after every other value.
+func greaterOrdered[T Ordered](a, b T) bool {
+	return a > b || (b != b && a == a)
+}
+
+// lessOrderedNaNLast returns true when a ranks before b in BottomK: smaller values first and NaN after every other value.
+func lessOrderedNaNLast[T Ordered](a, b T) bool {
+	return a < b || (b != b && a == a)
+}
+
+func identity[T any](v T) T {
+	return v
+}
+
+// selectK returns the k elements of the sequence whose keys come first according to better, ties are won by the earlier element.
+// The queue is preallocated for at most capacityHint elements.
+func selectK[T any, TKey any](seq Seq[T], k int, capacityHint int, keySelector func(T) TKey, better func(TKey, TKey) bool) []T {
+	if k <= 0 {
+		return emptyResult[T](nil)
+	}
+
+	type entry struct {
+		value T
+		key   TKey
+		index int
+	}
+	// The front of the queue is the worst of the kept elements, so it is the one to be replaced.
+	worse := func(a, b entry) bool {
+		if better(a.key, b.key) {
+			return false
+		}
+		if better(b.key, a.key) {
+			return true
+		}
+		return a.index > b.index
+	}
+	queue := NewPriorityQueue(worse)
+	if capacityHint > k {
+		capacityHint = k
+	}
+	queue.items = make([]entry, 0, capacityHint)
+
+	index := 0
+	seq(func(v T) bool {
+		e := entry{v, keySelector(v), index}
+		index++
+		if queue.Len() < k {
+			queue.Push(e)
+		} else if front, _ := queue.Peek(); worse(front, e) {
+			queue.replaceFront(e)
+		}
+		return true
+	})
+
+	result := make([]T, queue.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		e, _ := queue.Pop()
+		result[i] = e.value
+	}
+	return emptyResult(result)
+}
@@ -0,0 +1,85 @@
+package slinq
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	type score struct {
+		name  string
+		value int
+	}
+	value := func(s score) int { return s.value }
+	scores := []score{{"a", 3}, {"b", 7}, {"c", 5}, {"d", 7}, {"e", 1}, {"f", 5}}
+
+	tests := []struct {
+		name string
+		k    int
+		want []score
+	}{
+		{
+			name: "Should return the three largest, ties in original order",
+			k:    3,
+			want: []score{{"b", 7}, {"d", 7}, {"c", 5}},
+		},
+		{
+			name: "Should return all elements sorted when k exceeds length",
+			k:    10,
+			want: []score{{"b", 7}, {"d", 7}, {"c", 5}, {"f", 5}, {"a", 3}, {"e", 1}},
+		},
+		{
+			name: "Should return nil",
+			k:    0,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TopK(scores, tt.k, value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopK() = %v, want %v", got, tt.want)
+			}
+			if got := TopKSeq(FromSlice(scores), tt.k, value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopKSeq() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBottomK(t *testing.T) {
+	identity := func(i int) int { return i }
+
+	want := []int{1, 2, 2}
+	if got := BottomK([]int{5, 2, 9, 1, 2, 7}, 3, identity); !reflect.DeepEqual(got, want) {
+		t.Errorf("BottomK() = %v, want %v", got, want)
+	}
+
+	seq := TakeSeq(Iterate(100, func(i int) int { return i - 3 }), 50)
+	if got := BottomKSeq(seq, 2, identity); !reflect.DeepEqual(got, []int{-47, -44}) {
+		t.Errorf("BottomKSeq() = %v, want %v", got, []int{-47, -44})
+	}
+}
+
+func TestTopKNaN(t *testing.T) {
+	nan := math.NaN()
+	values := []float64{1, nan, 3, 2, nan}
+
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{name: "TopK should skip NaN", got: TopK(values, 2, identity[float64]), want: []float64{3, 2}},
+		{name: "BottomK should skip NaN", got: BottomK(values, 2, identity[float64]), want: []float64{1, 2}},
+		{name: "TopK should put NaN last", got: TopK(values, 4, identity[float64]), want: []float64{3, 2, 1, nan}},
+		{name: "BottomKSeq should put NaN last", got: BottomKSeq(FromSlice(values), 5, identity[float64]), want: []float64{1, 2, 3, nan, nan}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !floatsEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
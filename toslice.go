@@ -0,0 +1,53 @@
+package slinq
+
+import "sort"
+
+// KeyValue is an entry of a map.
+type KeyValue[TKey any, TValue any] struct {
+	Key   TKey
+	Value TValue
+}
+
+// ToSliceSorted returns a slice that was created by applying the provided selector to the key-value pairs of the provided map, in the order the provided key comparison defines.
+// Unlike ToSlice, the result doesn't depend on the random iteration order of the map, as long as keyLess is a strict total order of the keys.
+// Keys that keyLess considers equal without being the same key are selected in random order, because the map has no other order that could break the tie.
+func ToSliceSorted[TKey comparable, TValue any, TResult any](dict map[TKey]TValue, keyLess func(TKey, TKey) bool, selector func(TKey, TValue) TResult) []TResult {
+	entries := sortedEntries(dict, keyLess)
+	result := make([]TResult, len(entries))
+	for i, entry := range entries {
+		result[i] = selector(entry.Key, entry.Value)
+	}
+	return emptyResult(result)
+}
+
+// ToSliceOrdered is ToSliceSorted for maps with ordered keys, the key-value pairs are selected in ascending key order.
+// NaN keys come after all other keys, the entries of several NaN keys in random order.
+func ToSliceOrdered[TKey Ordered, TValue any, TResult any](dict map[TKey]TValue, selector func(TKey, TValue) TResult) []TResult {
+	return ToSliceSorted(dict, lessOrderedNaNLast[TKey], selector)
+}
+
+// SortedKeys returns the keys of the provided map in ascending order, NaN keys come last.
+func SortedKeys[TKey Ordered, TValue any](dict map[TKey]TValue) []TKey {
+	return ToSliceOrdered(dict, func(key TKey, _ TValue) TKey { return key })
+}
+
+// SortedValues returns the values of the provided map in ascending order of their keys, so that they line up with SortedKeys.
+func SortedValues[TKey Ordered, TValue any](dict map[TKey]TValue) []TValue {
+	return ToSliceOrdered(dict, func(_ TKey, value TValue) TValue { return value })
+}
+
+// SortedEntries returns the key-value pairs of the provided map in ascending order of their keys.
+func SortedEntries[TKey Ordered, TValue any](dict map[TKey]TValue) []KeyValue[TKey, TValue] {
+	return ToSliceOrdered(dict, func(key TKey, value TValue) KeyValue[TKey, TValue] { return KeyValue[TKey, TValue]{key, value} })
+}
+
+// sortedEntries returns the key-value pairs of the provided map sorted by keyLess.
+// The values are taken while iterating the map instead of being looked up by their key afterwards, which would not find NaN keys.
+func sortedEntries[TKey comparable, TValue any](dict map[TKey]TValue, keyLess func(TKey, TKey) bool) []KeyValue[TKey, TValue] {
+	entries := make([]KeyValue[TKey, TValue], 0, len(dict))
+	for key, value := range dict {
+		entries = append(entries, KeyValue[TKey, TValue]{key, value})
+	}
+	sort.Slice(entries, func(i, j int) bool { return keyLess(entries[i].Key, entries[j].Key) })
+	return entries
+}
@@ -0,0 +1,63 @@
+package slinq
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestToSliceSorted(t *testing.T) {
+	dict := map[string]int{"b": 2, "c": 3, "a": 1}
+	format := func(k string, v int) string { return fmt.Sprintf("%s=%d", k, v) }
+	descending := func(a, b string) bool { return a > b }
+
+	for i := 0; i < 10; i++ {
+		if got, want := ToSliceSorted(dict, descending, format), []string{"c=3", "b=2", "a=1"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("ToSliceSorted() = %v, want %v", got, want)
+		}
+		if got, want := ToSliceOrdered(dict, format), []string{"a=1", "b=2", "c=3"}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("ToSliceOrdered() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSortedKeysValuesEntries(t *testing.T) {
+	dict := map[int]string{3: "c", 1: "a", 2: "b"}
+
+	if got, want := SortedKeys(dict), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedKeys() = %v, want %v", got, want)
+	}
+	if got, want := SortedValues(dict), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedValues() = %v, want %v", got, want)
+	}
+	want := []KeyValue[int, string]{{1, "a"}, {2, "b"}, {3, "c"}}
+	if got := SortedEntries(dict); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedEntries() = %v, want %v", got, want)
+	}
+	if got := SortedKeys(map[int]string{}); got != nil {
+		t.Errorf("SortedKeys() = %v, want nil", got)
+	}
+}
+
+func TestToSliceOrderedWithGroupBy(t *testing.T) {
+	words := []string{"go", "rust", "c", "zig", "java", "d"}
+	length := func(s string) int { return len(s) }
+	grouping := func(k int, v []string) Grouping[int, string] { return Grouping[int, string]{k, v} }
+
+	want := []Grouping[int, string]{{1, []string{"c", "d"}}, {2, []string{"go"}}, {3, []string{"zig"}}, {4, []string{"rust", "java"}}}
+	if got := ToSliceOrdered(GroupBy(words, length), grouping); !reflect.DeepEqual(got, want) {
+		t.Errorf("ToSliceOrdered(GroupBy()) = %v, want %v", got, want)
+	}
+}
+
+func TestToSliceOrderedNaN(t *testing.T) {
+	dict := map[float64]int{math.NaN(): 5, 1: 2, -1: 3}
+
+	if got, want := SortedValues(dict), []int{3, 2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedValues() = %v, want %v", got, want)
+	}
+	if got, want := SortedKeys(dict), []float64{-1, 1, math.NaN()}; !floatsEqual(got, want) {
+		t.Errorf("SortedKeys() = %v, want %v", got, want)
+	}
+}
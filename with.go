@@ -0,0 +1,88 @@
+package slinq
+
+// hashSet is a set that uses an EqualityComparer instead of the == operator, values are kept in buckets by their hash.
+type hashSet[T any] struct {
+	comparer EqualityComparer[T]
+	buckets  map[uint64][]T
+}
+
+func newHashSet[T any](comparer EqualityComparer[T], capacity int) *hashSet[T] {
+	return &hashSet[T]{comparer, make(map[uint64][]T, capacity)}
+}
+
+// add adds the provided value and returns true if it was not contained yet.
+func (s *hashSet[T]) add(value T) bool {
+	hash := s.comparer.Hash(value)
+	for _, v := range s.buckets[hash] {
+		if s.comparer.Equals(v, value) {
+			return false
+		}
+	}
+	s.buckets[hash] = append(s.buckets[hash], value)
+	return true
+}
+
+func (s *hashSet[T]) contains(value T) bool {
+	for _, v := range s.buckets[s.comparer.Hash(value)] {
+		if s.comparer.Equals(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsWith returns true when the provided slice contains an element that the provided comparer considers equal to the provided value.
+// It only calls Equals, hashing every element would not make a single linear scan faster.
+func ContainsWith[T any](slice []T, value T, comparer EqualityComparer[T]) bool {
+	for _, v := range slice {
+		if comparer.Equals(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// DistinctWith removes values from the provided slice that the provided comparer considers duplicates.
+// Unlike Distinct, the first occurrence of every value is kept in its original order.
+func DistinctWith[T any](slice []T, comparer EqualityComparer[T]) []T {
+	var result []T
+	set := newHashSet(comparer, len(slice))
+	for _, v := range slice {
+		if set.add(v) {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+// ExceptWith returns the elements of the first provided slice that the provided comparer doesn't consider equal to any element of the second provided slice.
+// Like Except, duplicates in the first slice are kept.
+func ExceptWith[T any](first, second []T, comparer EqualityComparer[T]) []T {
+	var result []T
+	set := newHashSet(comparer, len(second))
+	for _, v := range second {
+		set.add(v)
+	}
+	for _, v := range first {
+		if !set.contains(v) {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
+
+// IntersectWith returns the elements of the second provided slice that the provided comparer considers equal to an element of the first provided slice.
+// Like Intersect, duplicates in the second slice are kept.
+func IntersectWith[T any](first, second []T, comparer EqualityComparer[T]) []T {
+	var result []T
+	set := newHashSet(comparer, len(first))
+	for _, v := range first {
+		set.add(v)
+	}
+	for _, v := range second {
+		if set.contains(v) {
+			result = append(result, v)
+		}
+	}
+	return emptyResult(result)
+}
@@ -0,0 +1,49 @@
+package slinq
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestDistinctWith(t *testing.T) {
+	slice := [][]byte{[]byte("a"), []byte("b"), []byte("a"), nil, {}}
+
+	want := [][]byte{[]byte("a"), []byte("b"), nil}
+	if got := DistinctWith(slice, BytesComparer()); !reflect.DeepEqual(got, want) {
+		t.Errorf("DistinctWith() = %q, want %q", got, want)
+	}
+}
+
+func TestExceptWith(t *testing.T) {
+	first := []map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 2}, {"id": 3}}
+	second := []map[string]interface{}{{"id": 2}}
+
+	want := []map[string]interface{}{{"id": 1}, {"id": 3}}
+	if got := ExceptWith(first, second, DeepEqualComparer[map[string]interface{}]()); !reflect.DeepEqual(got, want) {
+		t.Errorf("ExceptWith() = %v, want %v", got, want)
+	}
+}
+
+func TestIntersectWith(t *testing.T) {
+	first := []string{"Go", "Rust"}
+	second := []string{"go", "java", "RUST", "GO"}
+
+	want := []string{"go", "RUST", "GO"}
+	if got := IntersectWith(first, second, CaseInsensitiveComparer()); !reflect.DeepEqual(got, want) {
+		t.Errorf("IntersectWith() = %v, want %v", got, want)
+	}
+}
+
+func TestContainsWith(t *testing.T) {
+	slice := [][]byte{[]byte("a"), []byte("b")}
+
+	if !ContainsWith(slice, []byte("b"), BytesComparer()) || ContainsWith(slice, []byte("c"), BytesComparer()) {
+		t.Errorf("ContainsWith() did not use the provided comparer")
+	}
+
+	noHash := NewEqualityComparer(bytes.Equal, func([]byte) uint64 { panic("ContainsWith should not hash") })
+	if !ContainsWith(slice, []byte("a"), noHash) {
+		t.Errorf("ContainsWith() = false, want true")
+	}
+}